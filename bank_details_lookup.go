@@ -0,0 +1,62 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	bankDetailsLookupEndpoint = "bank_details_lookups"
+)
+
+type (
+	// BankDetailsLookup the result of validating bank details, e.g. before creating a customer bank account
+	BankDetailsLookup struct {
+		// AvailableDebitSchemes the Direct Debit schemes the bank account can be debited through, e.g. bacs.
+		// An empty list means the account does not support Direct Debit
+		AvailableDebitSchemes []string `json:"available_debit_schemes"`
+		// BankName Name of bank, taken from the bank details
+		BankName string `json:"bank_name"`
+		// BIC ISO 9362 SWIFT BIC of the bank
+		BIC string `json:"bic"`
+	}
+	// bankDetailsLookupWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	bankDetailsLookupWrapper struct {
+		BankDetailsLookup *BankDetailsLookup `json:"bank_details_lookups"`
+	}
+)
+
+func (l *BankDetailsLookup) String() string {
+	bs, _ := json.Marshal(l)
+	return string(bs)
+}
+
+// LookupBankDetails validates the bank details of cba and returns the bank they belong to.
+// Only the bank details are used: AccountNumber, BankCode, BranchCode, CountryCode and IBAN.
+// Invalid bank details result in a validation_failed error, see Error.ValidationErrors
+//
+// Relative endpoint: POST /bank_details_lookups
+func (c *Client) LookupBankDetails(ctx context.Context, cba *CustomerBankAccount) (*BankDetailsLookup, error) {
+	if cba == nil {
+		return nil, errors.New("gocardless: customer bank account is required")
+	}
+
+	// allows only the bank details
+	lookupData := map[string]interface{}{
+		"bank_details_lookups": nonEmpty(map[string]string{
+			"account_number": cba.AccountNumber,
+			"bank_code":      cba.BankCode,
+			"branch_code":    cba.BranchCode,
+			"country_code":   cba.CountryCode,
+			"iban":           cba.IBAN,
+		}),
+	}
+
+	wrapper := &bankDetailsLookupWrapper{}
+	err := c.post(ctx, bankDetailsLookupEndpoint, lookupData, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.BankDetailsLookup, err
+}
@@ -0,0 +1,63 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestLookupBankDetailsOmitsBlankFields(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"bank_details_lookups":{"bank_name":"BARCLAYS BANK PLC"}}`))
+
+	_, err := c.LookupBankDetails(context.Background(), &CustomerBankAccount{
+		AccountNumber: "55779911",
+		BranchCode:    "200000",
+		CountryCode:   "GB",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var req struct {
+		BankDetailsLookups map[string]interface{} `json:"bank_details_lookups"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.BankDetailsLookups) != 3 {
+		t.Errorf("sent %s, want only account_number, branch_code and country_code", body)
+	}
+
+	if _, err := c.LookupBankDetails(context.Background(), nil); err == nil {
+		t.Error("LookupBankDetails(nil) returned no error")
+	}
+}
+
+func TestCreateMandatePDFWithDetailsOmitsBlankFields(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"mandate_pdfs":{"url":"https://example.com/mandate.pdf"}}`))
+
+	customer := &Customer{GivenName: "Frank", FamilyName: "Osborne", CountryCode: "GB"}
+	cba := &CustomerBankAccount{AccountHolderName: "Frank Osborne", AccountNumber: "55779911", BranchCode: "200000"}
+	if _, err := c.CreateMandatePDFWithDetails(context.Background(), customer, cba, "", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	var req struct {
+		MandatePDFs map[string]interface{} `json:"mandate_pdfs"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.MandatePDFs) != 6 || req.MandatePDFs["country_code"] != "GB" {
+		t.Errorf("sent %s, want only the 6 fields set", body)
+	}
+
+	if _, err := c.CreateMandatePDFWithDetails(context.Background(), nil, cba, "", ""); err == nil {
+		t.Error("CreateMandatePDFWithDetails(nil customer) returned no error")
+	}
+	if _, err := c.CreateMandatePDFWithDetails(context.Background(), customer, nil, "", ""); err == nil {
+		t.Error("CreateMandatePDFWithDetails(nil bank account) returned no error")
+	}
+}
@@ -0,0 +1,398 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	billingRequestEndpoint = "billing_requests"
+)
+
+type (
+	// BillingRequest Billing Requests collect everything needed to set up a mandate, take a payment, or both,
+	// e.g. an instant bank payment followed by Direct Debit collections, in a single customer journey.
+	BillingRequest struct {
+		// ID is a unique identifier, beginning with "BRQ".
+		ID string `json:"id,omitempty"`
+		// CreatedAt is a fixed timestamp, recording when the billing request was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// Status status of billing request, e.g. pending, ready_to_fulfil or fulfilled.
+		Status string `json:"status,omitempty"`
+		// MandateRequest the mandate to set up, if any
+		MandateRequest *BillingRequestMandateRequest `json:"mandate_request,omitempty"`
+		// PaymentRequest the instant payment to take, if any
+		PaymentRequest *BillingRequestPaymentRequest `json:"payment_request,omitempty"`
+		// FallbackEnabled whether to fall back to a Direct Debit mandate if the bank does not support instant payments
+		FallbackEnabled bool `json:"fallback_enabled,omitempty"`
+		// Actions the actions which must be completed before the billing request can be fulfilled
+		Actions []*BillingRequestAction `json:"actions,omitempty"`
+		// Resources the customer details collected so far
+		Resources *BillingRequestResources `json:"resources,omitempty"`
+		// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+		// characters and values up to 500 characters.
+		Metadata map[string]string `json:"metadata,omitempty"`
+		// Links to the customer, bank account and the created mandate and payment
+		Links billingRequestLinks `json:"links"`
+	}
+	billingRequestLinks struct {
+		CreditorID              string `json:"creditor,omitempty"`
+		CustomerID              string `json:"customer,omitempty"`
+		CustomerBankAccountID   string `json:"customer_bank_account,omitempty"`
+		CustomerBillingDetailID string `json:"customer_billing_detail,omitempty"`
+		MandateRequestID        string `json:"mandate_request,omitempty"`
+		MandateRequestMandateID string `json:"mandate_request_mandate,omitempty"`
+		PaymentRequestID        string `json:"payment_request,omitempty"`
+		PaymentRequestPaymentID string `json:"payment_request_payment,omitempty"`
+	}
+
+	// BillingRequestMandateRequest the mandate requested by a billing request
+	BillingRequestMandateRequest struct {
+		// Currency currency code of the mandate
+		Currency string `json:"currency"`
+		// Scheme Direct Debit scheme of the mandate, defaults to the scheme of the currency
+		Scheme string `json:"scheme,omitempty"`
+		// Verify verification preference of the mandate, one of minimum, recommended, when_available or always
+		Verify string `json:"verify,omitempty"`
+		// Links to the mandate created once the billing request is fulfilled
+		Links struct {
+			MandateID string `json:"mandate,omitempty"`
+		} `json:"links"`
+	}
+
+	// BillingRequestPaymentRequest the instant payment requested by a billing request
+	BillingRequestPaymentRequest struct {
+		// Amount in pence (GBP), cents (EUR).
+		Amount int `json:"amount"`
+		// Currency currency code, GBP or EUR
+		Currency string `json:"currency"`
+		// Description A human-readable description of the payment, shown to the customer
+		Description string `json:"description,omitempty"`
+		// AppFee The amount to be deducted from the payment as the OAuth app’s fee, in pence/cents
+		AppFee int `json:"app_fee,omitempty"`
+		// Scheme payment scheme, defaults to faster_payments for GBP and sepa_credit_transfer for EUR
+		Scheme string `json:"scheme,omitempty"`
+		// Links to the payment created once the billing request is fulfilled
+		Links struct {
+			PaymentID string `json:"payment,omitempty"`
+		} `json:"links"`
+	}
+
+	// BillingRequestAction an action to be completed on a billing request
+	BillingRequestAction struct {
+		// Type of action, e.g. collect_customer_details or collect_bank_account
+		Type string `json:"type"`
+		// Required whether the action must be completed before the billing request can be fulfilled
+		Required bool `json:"required"`
+		// Status of the action, pending or completed
+		Status string `json:"status"`
+		// CompletesActions the actions completed by this action
+		CompletesActions []string `json:"completes_actions,omitempty"`
+		// RequiresActions the actions to complete before this one
+		RequiresActions []string `json:"requires_actions,omitempty"`
+		// AvailableCurrencies the currencies the customer may choose from, for the choose_currency action
+		AvailableCurrencies []string `json:"available_currencies,omitempty"`
+	}
+
+	// BillingRequestResources the resources created while collecting the customer details
+	BillingRequestResources struct {
+		Customer              *Customer              `json:"customer,omitempty"`
+		CustomerBankAccount   *CustomerBankAccount   `json:"customer_bank_account,omitempty"`
+		CustomerBillingDetail *CustomerBillingDetail `json:"customer_billing_detail,omitempty"`
+	}
+
+	// CustomerBillingDetail the address of a customer collected by a billing request
+	CustomerBillingDetail struct {
+		ID                    string     `json:"id,omitempty"`
+		CreatedAt             *time.Time `json:"created_at,omitempty"`
+		AddressLine1          string     `json:"address_line1,omitempty"`
+		AddressLine2          string     `json:"address_line2,omitempty"`
+		AddressLine3          string     `json:"address_line3,omitempty"`
+		City                  string     `json:"city,omitempty"`
+		Region                string     `json:"region,omitempty"`
+		PostalCode            string     `json:"postal_code,omitempty"`
+		CountryCode           string     `json:"country_code,omitempty"`
+		SwedishIdentityNumber string     `json:"swedish_identity_number,omitempty"`
+	}
+
+	// billingRequestWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	billingRequestWrapper struct {
+		BillingRequest *BillingRequest `json:"billing_requests"`
+	}
+
+	// BillingRequestListResponse a List response of BillingRequest instances
+	BillingRequestListResponse struct {
+		BillingRequests []*BillingRequest `json:"billing_requests"`
+		Meta            Meta              `json:"meta,omitempty"`
+	}
+
+	// BillingRequestListParams parameters to filter and paginate the list of billing requests
+	BillingRequestListParams struct {
+		ListParams
+		// CreatedAt filters billing requests by creation time
+		CreatedAt TimeRange
+		// Customer only returns billing requests of this customer ID
+		Customer string
+		// Status only returns billing requests in this status, e.g. pending
+		Status string
+	}
+
+	// BillingRequestIterator iterates over a list of billing requests, fetching further pages as needed
+	BillingRequestIterator struct {
+		pager
+		page []*BillingRequest
+	}
+)
+
+func (br *BillingRequest) String() string {
+	bs, _ := json.Marshal(br)
+	return string(bs)
+}
+
+// NewMandateBillingRequest instantiate new billing request object setting up a mandate in currency
+func NewMandateBillingRequest(currency string) *BillingRequest {
+	return &BillingRequest{
+		MandateRequest: &BillingRequestMandateRequest{Currency: currency},
+	}
+}
+
+// NewPaymentBillingRequest instantiate new billing request object taking an instant payment,
+// set MandateRequest as well to set up a mandate in the same journey
+func NewPaymentBillingRequest(amount int, currency, description string) *BillingRequest {
+	return &BillingRequest{
+		PaymentRequest: &BillingRequestPaymentRequest{
+			Amount:      amount,
+			Currency:    currency,
+			Description: description,
+		},
+	}
+}
+
+// AddMetadata adds new metadata item to billing request object
+func (br *BillingRequest) AddMetadata(key, value string) {
+	if br.Metadata == nil {
+		br.Metadata = make(map[string]string)
+	}
+	br.Metadata[key] = value
+}
+
+// CreateBillingRequest creates a new billing request object.
+//
+// Relative endpoint: POST /billing_requests
+func (c *Client) CreateBillingRequest(ctx context.Context, billingRequest *BillingRequest) error {
+	billingRequestReq := &billingRequestWrapper{billingRequest}
+
+	err := c.create(ctx, billingRequestEndpoint, billingRequestReq, billingRequestReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// GetBillingRequests returns a cursor-paginated list of your billing requests.
+//
+// Relative endpoint: GET /billing_requests
+func (c *Client) GetBillingRequests(ctx context.Context) (*BillingRequestListResponse, error) {
+	return c.GetBillingRequestsWithParams(ctx, nil)
+}
+
+// GetBillingRequestsWithParams is GetBillingRequests with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /billing_requests
+func (c *Client) GetBillingRequestsWithParams(ctx context.Context, params *BillingRequestListParams) (*BillingRequestListResponse, error) {
+	list := &BillingRequestListResponse{}
+
+	err := c.get(ctx, listPath(billingRequestEndpoint, params.values()), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *BillingRequestListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "customer", params.Customer)
+	setString(query, "status", params.Status)
+	return query
+}
+
+// BillingRequests returns an iterator over all your billing requests matching params,
+// following the pagination cursors. params may be nil.
+//
+// Relative endpoint: GET /billing_requests
+func (c *Client) BillingRequests(ctx context.Context, params *BillingRequestListParams) *BillingRequestIterator {
+	it := &BillingRequestIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &BillingRequestListResponse{}
+		if err := c.get(ctx, listPath(billingRequestEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.BillingRequests
+		return len(list.BillingRequests), list.Meta, nil
+	})
+	return it
+}
+
+// BillingRequest returns the current billing request, or nil unless the last call to Next returned true
+func (it *BillingRequestIterator) BillingRequest() *BillingRequest {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// GetBillingRequest retrieves the details of an existing billing request.
+//
+// Relative endpoint: GET /billing_requests/BRQ123
+func (c *Client) GetBillingRequest(ctx context.Context, id string) (*BillingRequest, error) {
+	wrapper := &billingRequestWrapper{}
+
+	err := c.get(ctx, fmt.Sprintf(`%s/%s`, billingRequestEndpoint, id), wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequest, err
+}
+
+// CollectBillingRequestCustomerDetails stores the contact details and address of customer on the billing request.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/collect_customer_details
+func (c *Client) CollectBillingRequestCustomerDetails(ctx context.Context, id string, customer *Customer) (*BillingRequest, error) {
+	if customer == nil {
+		return nil, errors.New("gocardless: customer is required")
+	}
+
+	customerData := nonEmpty(map[string]string{
+		"email":        customer.Email,
+		"given_name":   customer.GivenName,
+		"family_name":  customer.FamilyName,
+		"company_name": customer.CompanyName,
+		"language":     customer.Language,
+	})
+	if customer.Metadata != nil {
+		customerData["metadata"] = customer.Metadata
+	}
+
+	brData := map[string]interface{}{
+		"customer": customerData,
+		"customer_billing_detail": &CustomerBillingDetail{
+			AddressLine1:          customer.AddressLine1,
+			AddressLine2:          customer.AddressLine2,
+			AddressLine3:          customer.AddressLine3,
+			City:                  customer.City,
+			Region:                customer.Region,
+			PostalCode:            customer.PostalCode,
+			CountryCode:           customer.CountryCode,
+			SwedishIdentityNumber: customer.SwedishIdentityNumber,
+		},
+	}
+	return c.billingRequestAction(ctx, id, "collect_customer_details", brData)
+}
+
+// CollectBillingRequestBankAccount stores the bank details of the customer on the billing request,
+// either the local details or the IBAN.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/collect_bank_account
+func (c *Client) CollectBillingRequestBankAccount(ctx context.Context, id string, cba *CustomerBankAccount) (*BillingRequest, error) {
+	if cba == nil {
+		return nil, errors.New("gocardless: customer bank account is required")
+	}
+
+	brData := nonEmpty(map[string]string{
+		"account_holder_name": cba.AccountHolderName,
+		"account_number":      cba.AccountNumber,
+		"bank_code":           cba.BankCode,
+		"branch_code":         cba.BranchCode,
+		"country_code":        cba.CountryCode,
+		"currency":            cba.Currency,
+		"iban":                cba.IBAN,
+	})
+	if cba.Metadata != nil {
+		brData["metadata"] = cba.Metadata
+	}
+	return c.billingRequestAction(ctx, id, "collect_bank_account", brData)
+}
+
+// ConfirmBillingRequestPayerDetails confirms the customer and bank account details collected,
+// required for mandate requests before the billing request can be fulfilled.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/confirm_payer_details
+func (c *Client) ConfirmBillingRequestPayerDetails(ctx context.Context, id string) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, "confirm_payer_details", nil)
+}
+
+// FulfilBillingRequest creates the mandate and payment requested once all the required actions are completed.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/fulfil
+func (c *Client) FulfilBillingRequest(ctx context.Context, id string) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, "fulfil", nil)
+}
+
+// CancelBillingRequest immediately cancels a billing request, it can no longer be fulfilled.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/cancel
+func (c *Client) CancelBillingRequest(ctx context.Context, id string) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, "cancel", nil)
+}
+
+// NotifyBillingRequest emails the customer a link to complete the billing request,
+// they are sent back to redirectURI once it is complete.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/notify
+func (c *Client) NotifyBillingRequest(ctx context.Context, id, redirectURI string) (*BillingRequest, error) {
+	brData := map[string]interface{}{
+		"notification_type": "email",
+		"redirect_uri":      redirectURI,
+	}
+	return c.billingRequestAction(ctx, id, "notify", brData)
+}
+
+// ChooseBillingRequestCurrency sets the currency of the mandate and payment requested
+// when the customer chooses from the available currencies.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/choose_currency
+func (c *Client) ChooseBillingRequestCurrency(ctx context.Context, id, currency string) (*BillingRequest, error) {
+	brData := map[string]interface{}{
+		"currency": currency,
+	}
+	return c.billingRequestAction(ctx, id, "choose_currency", brData)
+}
+
+// SelectBillingRequestInstitution sets the bank the customer authorises the instant payment with,
+// institution is the ID of an institution in countryCode.
+//
+// Relative endpoint: POST /billing_requests/BRQ123/actions/select_institution
+func (c *Client) SelectBillingRequestInstitution(ctx context.Context, id, institution, countryCode string) (*BillingRequest, error) {
+	brData := map[string]interface{}{
+		"institution":  institution,
+		"country_code": countryCode,
+	}
+	return c.billingRequestAction(ctx, id, "select_institution", brData)
+}
+
+// billingRequestAction performs action on the billing request, data is sent as the action's parameters
+func (c *Client) billingRequestAction(ctx context.Context, id, action string, data interface{}) (*BillingRequest, error) {
+	var brData interface{}
+	if data != nil {
+		brData = map[string]interface{}{
+			"data": data,
+		}
+	}
+
+	wrapper := &billingRequestWrapper{}
+	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/%s`, billingRequestEndpoint, id, action), brData, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequest, err
+}
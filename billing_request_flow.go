@@ -0,0 +1,115 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	billingRequestFlowEndpoint = "billing_request_flows"
+)
+
+type (
+	// BillingRequestFlow Billing Request Flows create a link to the hosted payment pages,
+	// where the customer completes the actions of a billing request.
+	BillingRequestFlow struct {
+		// ID is a unique identifier, beginning with "BRF".
+		ID string `json:"id,omitempty"`
+		// CreatedAt is a fixed timestamp, recording when the flow was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// AuthorisationURL the URL of the hosted payment pages to send the customer to.
+		AuthorisationURL string `json:"authorisation_url,omitempty"`
+		// ExpiresAt the time after which the flow can no longer be used.
+		ExpiresAt *time.Time `json:"expires_at,omitempty"`
+		// RedirectURI the URL to redirect the customer to once the flow is complete.
+		RedirectURI string `json:"redirect_uri,omitempty"`
+		// ExitURI the URL to redirect the customer to when they leave the flow without completing it.
+		ExitURI string `json:"exit_uri,omitempty"`
+		// AutoFulfil whether the billing request is fulfilled as soon as the flow is complete, defaults to true
+		AutoFulfil *bool `json:"auto_fulfil,omitempty"`
+		// LockBankAccount prevents the customer from changing the prefilled bank account details
+		LockBankAccount bool `json:"lock_bank_account,omitempty"`
+		// LockCustomerDetails prevents the customer from changing the prefilled customer details
+		LockCustomerDetails bool `json:"lock_customer_details,omitempty"`
+		// ShowRedirectButtons shows buttons linking to the redirect and exit URIs on the pages
+		ShowRedirectButtons bool `json:"show_redirect_buttons,omitempty"`
+		// Language ISO 639-1 code of the language of the pages
+		Language string `json:"language,omitempty"`
+		// prefill the details of a customer
+		Customer *Customer `json:"prefilled_customer,omitempty"`
+		// Links to the billing request
+		Links billingRequestFlowLinks `json:"links"`
+	}
+	billingRequestFlowLinks struct {
+		BillingRequestID string `json:"billing_request"`
+	}
+	// billingRequestFlowRequest the flow sent to the API, prefilling only the customer details it supports
+	billingRequestFlowRequest struct {
+		*BillingRequestFlow
+		Customer map[string]interface{} `json:"prefilled_customer,omitempty"`
+	}
+	// billingRequestFlowWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	billingRequestFlowWrapper struct {
+		BillingRequestFlow *BillingRequestFlow `json:"billing_request_flows"`
+	}
+)
+
+func (f *BillingRequestFlow) String() string {
+	bs, _ := json.Marshal(f)
+	return string(bs)
+}
+
+// NewBillingRequestFlow instantiate new billing request flow object for a billing request
+func NewBillingRequestFlow(billingRequestID, redirectURI string) *BillingRequestFlow {
+	return &BillingRequestFlow{
+		RedirectURI: redirectURI,
+		Links:       billingRequestFlowLinks{BillingRequestID: billingRequestID},
+	}
+}
+
+// CreateBillingRequestFlow creates a new billing request flow object, send the customer to its AuthorisationURL.
+//
+// Relative endpoint: POST /billing_request_flows
+func (c *Client) CreateBillingRequestFlow(ctx context.Context, flow *BillingRequestFlow) error {
+	flowData := &billingRequestFlowRequest{BillingRequestFlow: flow}
+	if flow.Customer != nil {
+		// allows only the contact and address details, leaving out the unset ones
+		flowData.Customer = nonEmpty(map[string]string{
+			"email":                   flow.Customer.Email,
+			"given_name":              flow.Customer.GivenName,
+			"family_name":             flow.Customer.FamilyName,
+			"company_name":            flow.Customer.CompanyName,
+			"address_line1":           flow.Customer.AddressLine1,
+			"address_line2":           flow.Customer.AddressLine2,
+			"address_line3":           flow.Customer.AddressLine3,
+			"city":                    flow.Customer.City,
+			"region":                  flow.Customer.Region,
+			"postal_code":             flow.Customer.PostalCode,
+			"country_code":            flow.Customer.CountryCode,
+			"swedish_identity_number": flow.Customer.SwedishIdentityNumber,
+		})
+	}
+
+	flowReq := &billingRequestFlowWrapper{flow}
+
+	err := c.post(ctx, billingRequestFlowEndpoint, map[string]interface{}{"billing_request_flows": flowData}, flowReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// InitialiseBillingRequestFlow returns the flow to its initial state, e.g. when the customer wants to start over.
+//
+// Relative endpoint: POST /billing_request_flows/BRF123/actions/initialise
+func (c *Client) InitialiseBillingRequestFlow(ctx context.Context, id string) (*BillingRequestFlow, error) {
+	wrapper := &billingRequestFlowWrapper{}
+	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/initialise`, billingRequestFlowEndpoint, id), nil, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequestFlow, err
+}
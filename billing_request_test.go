@@ -0,0 +1,100 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestCollectBillingRequestOmitsBlankFields(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"billing_requests":{"id":"BRQ123"}}`))
+
+	_, err := c.CollectBillingRequestBankAccount(context.Background(), "BRQ123", &CustomerBankAccount{
+		AccountHolderName: "Frank Osborne",
+		AccountNumber:     "55779911",
+		BranchCode:        "200000",
+		CountryCode:       "GB",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bankReq struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &bankReq); err != nil {
+		t.Fatal(err)
+	}
+	if len(bankReq.Data) != 4 || bankReq.Data["account_number"] != "55779911" {
+		t.Errorf("sent %s, want only the 4 fields set", body)
+	}
+
+	_, err = c.CollectBillingRequestCustomerDetails(context.Background(), "BRQ123", &Customer{
+		Email:       "user@example.com",
+		GivenName:   "Frank",
+		FamilyName:  "Osborne",
+		CountryCode: "GB",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var customerReq struct {
+		Data struct {
+			Customer              map[string]interface{} `json:"customer"`
+			CustomerBillingDetail map[string]interface{} `json:"customer_billing_detail"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &customerReq); err != nil {
+		t.Fatal(err)
+	}
+	if len(customerReq.Data.Customer) != 3 || len(customerReq.Data.CustomerBillingDetail) != 1 {
+		t.Errorf("sent %s, want only the fields set", body)
+	}
+}
+
+func TestCollectBillingRequestRequiresDetails(t *testing.T) {
+	c := newTestClient(t, recordBody(new([]byte), `{}`))
+
+	if _, err := c.CollectBillingRequestBankAccount(context.Background(), "BRQ123", nil); err == nil {
+		t.Error("CollectBillingRequestBankAccount(nil) returned no error")
+	}
+	if _, err := c.CollectBillingRequestCustomerDetails(context.Background(), "BRQ123", nil); err == nil {
+		t.Error("CollectBillingRequestCustomerDetails(nil) returned no error")
+	}
+}
+
+func TestCreateBillingRequestFlowPrefillsSetFields(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"billing_request_flows":{"id":"BRF123"}}`))
+
+	flow := NewBillingRequestFlow("BRQ123", "https://example.com/done")
+	flow.Customer = &Customer{
+		Email:    "user@example.com",
+		Language: "en",
+		Metadata: map[string]string{"ref": "123"},
+	}
+	if err := c.CreateBillingRequestFlow(context.Background(), flow); err != nil {
+		t.Fatal(err)
+	}
+
+	var req struct {
+		BillingRequestFlows struct {
+			RedirectURI       string                 `json:"redirect_uri"`
+			PrefilledCustomer map[string]interface{} `json:"prefilled_customer"`
+			Links             map[string]interface{} `json:"links"`
+		} `json:"billing_request_flows"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	sent := req.BillingRequestFlows
+	if len(sent.PrefilledCustomer) != 1 || sent.PrefilledCustomer["email"] != "user@example.com" {
+		t.Errorf("sent prefilled_customer %v, want only the email", sent.PrefilledCustomer)
+	}
+	if sent.RedirectURI != "https://example.com/done" || sent.Links["billing_request"] != "BRQ123" {
+		t.Errorf("sent %s, want the redirect URI and billing request", body)
+	}
+	if flow.ID != "BRF123" {
+		t.Errorf("flow ID = %q, want BRF123", flow.ID)
+	}
+}
@@ -6,9 +6,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 )
@@ -25,8 +25,21 @@ type Client struct {
 	AccessToken string
 	// RemoteURL is the address of the GoCardless API
 	RemoteURL string
+	// RetryPolicy controls how rate limited and failed requests are retried, the zero value disables retries
+	RetryPolicy RetryPolicy
+	// RateLimiter when set, throttles requests client-side to stay within the rate limit of the access token
+	RateLimiter *RateLimiter
+	// ResolveIdempotentConflicts makes the create methods fetch the existing resource into the passed struct
+	// when the API reports an idempotent_creation_conflict, instead of returning the error
+	ResolveIdempotentConflicts bool
 	// httpClient used for APi requests
 	httpClient *http.Client
+	// apiVersion sent in the GoCardless-Version header
+	apiVersion string
+	// userAgent sent in the User-Agent header, if set
+	userAgent string
+	// logger reports retried requests, if set
+	logger Logger
 }
 
 // NewClient instantiate a client struct with your access token and environment, then
@@ -36,20 +49,12 @@ func NewClient(accessToken string, env Environment) *Client {
 }
 
 // NewClientWithHTTPClient instantiate a client struct with your access token and environment, then
-// use the resource methods to access the API. Uses existing http.Client to allow customisations
+// use the resource methods to access the API. Uses existing http.Client to allow customisations.
+// It panics when env is invalid, use New to handle the error instead
 func NewClientWithHTTPClient(hc *http.Client, accessToken string, env Environment) *Client {
-	c := &Client{
-		AccessToken: accessToken,
-		httpClient:  hc,
-	}
-
-	switch env {
-	case SandboxEnvironment:
-		c.RemoteURL = baseSandboxURL
-	case LiveEnvironment:
-		c.RemoteURL = baseLiveURL
-	default:
-		log.Fatalf("Invalid environment %s, use one of (%s, %s)", env, SandboxEnvironment, LiveEnvironment)
+	c, err := New(accessToken, WithHTTPClient(hc), WithEnvironment(env))
+	if err != nil {
+		panic(err)
 	}
 	return c
 }
@@ -60,22 +65,83 @@ func (c *Client) makeRequest(ctx context.Context, path, method string, body, dst
 		return err
 	}
 
-	resp, err := c.httpClient.Do(req)
-
-	if err != nil {
-		return err
+	for attempt := 1; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		res := newResponse(resp)
+		if c.RateLimiter != nil {
+			c.RateLimiter.update(res)
+		}
+		if hook := responseHookFromContext(ctx); hook != nil {
+			hook(res)
+		}
+		if !c.RetryPolicy.retryable(attempt, resp.StatusCode) {
+			return c.handleResponse(res, dst)
+		}
+
+		wait := c.RetryPolicy.backoff(attempt, res)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			// the next attempt could not complete before the deadline, report this failure instead
+			return c.handleResponse(res, dst)
+		}
+		res.Body.Close()
+
+		if c.logger != nil {
+			c.logger.Printf("gocardless: %s %s failed with status %d, retrying in %s (attempt %d of %d)",
+				req.Method, req.URL.Path, res.StatusCode, wait, attempt+1, c.RetryPolicy.MaxAttempts)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		// the cloned request keeps the same headers, including the Idempotency-Key,
+		// so a retried POST can not create the resource twice
+		req, err = retryRequest(ctx, req)
+		if err != nil {
+			return err
+		}
 	}
+}
 
-	switch resp.StatusCode {
+func (c *Client) handleResponse(res *Response, dst interface{}) error {
+	switch res.StatusCode {
 	case http.StatusTooManyRequests:
-		return errors.New("StatusTooManyRequests")
+		rateErr := &RateLimitedExceededError{Reset: res.RateReset()}
+		errors.As(res.bind(nil), &rateErr.Err)
+		return rateErr
 	}
 
-	res := newResponse(resp)
 	// bind response to struct
 	return res.bind(dst)
 }
 
+// retryRequest returns a copy of req with a fresh body, ready to be sent again
+func retryRequest(ctx context.Context, req *http.Request) (*http.Request, error) {
+	retry := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
+
 func (c *Client) newRequest(ctx context.Context, path string, method string, body interface{}) (*http.Request, error) {
 	if strings.ToUpper(method) == http.MethodPatch {
 		return nil, errors.New(InvalidMethodError)
@@ -96,20 +162,45 @@ func (c *Client) newRequest(ctx context.Context, path string, method string, bod
 
 	// set default headers
 	c.setDefaultHeaders(req)
+	if header, ok := ctx.Value(requestHeaderContextKey{}).(http.Header); ok {
+		for key, values := range header {
+			req.Header[key] = values
+		}
+	}
 
 	if method == http.MethodPost {
 		// Add Idempotency header key when creating a resouce
 		// https://developer.gocardless.com/api-reference/#making-requests-idempotency-keys
-		u, _ := uuid.NewV4()
-		req.Header.Add("Idempotency-Key", u.String())
+		key, ok := IdempotencyKeyFromContext(ctx)
+		if !ok {
+			u, _ := uuid.NewV4()
+			key = u.String()
+		}
+		req.Header.Add("Idempotency-Key", key)
 	}
 
 	return req, nil
 }
 
+// requestHeaderContextKey carries the extra headers of a single request, e.g. Accept-Language
+type requestHeaderContextKey struct{}
+
+// withRequestHeader returns a copy of ctx adding the header key to the requests made using it
+func withRequestHeader(ctx context.Context, key, value string) context.Context {
+	header := http.Header{}
+	if parent, ok := ctx.Value(requestHeaderContextKey{}).(http.Header); ok {
+		header = parent.Clone()
+	}
+	header.Set(key, value)
+	return context.WithValue(ctx, requestHeaderContextKey{}, header)
+}
+
 func (c *Client) setDefaultHeaders(req *http.Request) {
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.AccessToken))
-	req.Header.Add("GoCardless-Version", apiVersion)
+	req.Header.Add("GoCardless-Version", c.apiVersion)
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 }
@@ -122,10 +213,28 @@ func (c *Client) post(ctx context.Context, path string, body, dst interface{}) e
 	return c.makeRequest(ctx, path, http.MethodPost, body, dst)
 }
 
+// create posts a new resource to endpoint, resolving idempotent creation conflicts when the client is set up to
+func (c *Client) create(ctx context.Context, endpoint string, body, dst interface{}) error {
+	err := c.post(ctx, endpoint, body, dst)
+	if err == nil || !c.ResolveIdempotentConflicts {
+		return err
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	id := apiErr.ConflictingResourceID()
+	if id == "" {
+		return err
+	}
+	return c.get(ctx, fmt.Sprintf(`%s/%s`, endpoint, id), dst)
+}
+
 func (c *Client) put(ctx context.Context, path string, body, dst interface{}) error {
 	return c.makeRequest(ctx, path, http.MethodPut, body, dst)
 }
 
-func (c *Client) delete(ctx context.Context, path string) error {
-	return c.makeRequest(ctx, path, http.MethodDelete, nil, nil)
+func (c *Client) delete(ctx context.Context, path string, body interface{}) error {
+	return c.makeRequest(ctx, path, http.MethodDelete, body, nil)
 }
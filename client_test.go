@@ -0,0 +1,69 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// conflictingCustomers answers customer creations with an idempotent creation conflict linking to id,
+// and serves the customer with id, counting the GET requests
+func conflictingCustomers(id string, gets *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/customers":
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"message":"A resource has already been created with this idempotency key",` +
+				`"type":"invalid_state","code":409,"errors":[{"reason":"idempotent_creation_conflict",` +
+				`"message":"A resource has already been created with this idempotency key",` +
+				`"links":{"conflicting_resource_id":"` + id + `"}}]}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/customers/"+id:
+			*gets++
+			w.Write([]byte(`{"customers":{"id":"` + id + `","given_name":"Frank"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func TestCreateResolvesIdempotentConflicts(t *testing.T) {
+	var gets int
+	c := newTestClient(t, conflictingCustomers("CU123", &gets))
+	c.ResolveIdempotentConflicts = true
+
+	customer := &Customer{GivenName: "Frank", FamilyName: "Osborne"}
+	if err := c.CreateCustomer(context.Background(), customer); err != nil {
+		t.Fatal(err)
+	}
+	if customer.ID != "CU123" || gets != 1 {
+		t.Errorf("got customer %s after %d GET requests, want CU123 after 1", customer.ID, gets)
+	}
+}
+
+func TestCreateReturnsUnresolvedConflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		resolve bool
+	}{
+		{"resolution disabled", "CU123", false},
+		{"missing conflicting resource ID", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gets int
+			c := newTestClient(t, conflictingCustomers(tt.id, &gets))
+			c.ResolveIdempotentConflicts = tt.resolve
+
+			err := c.CreateCustomer(context.Background(), &Customer{GivenName: "Frank", FamilyName: "Osborne"})
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || !errors.Is(err, ErrIdempotentCreationConflict) {
+				t.Errorf("CreateCustomer() = %v, want the idempotent creation conflict", err)
+			}
+			if gets != 0 {
+				t.Errorf("got %d GET requests, want 0", gets)
+			}
+		})
+	}
+}
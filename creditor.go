@@ -0,0 +1,235 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	creditorEndpoint = "creditors"
+)
+
+type (
+	// Creditor objects represent the person or company collecting payments, i.e. you or, for partner
+	// integrations, the merchants you collect payments for
+	Creditor struct {
+		// ID is a unique identifier, beginning with "CR".
+		ID string `json:"id,omitempty"`
+		// CreatedAt is a fixed timestamp, recording when the creditor was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// Name is the creditor’s name.
+		Name string `json:"name"`
+		// AddressLine1 is the first line of the creditor’s address.
+		AddressLine1 string `json:"address_line1,omitempty"`
+		// AddressLine2 is the second line of the creditor’s address.
+		AddressLine2 string `json:"address_line2,omitempty"`
+		// AddressLine3 is the third line of the creditor’s address.
+		AddressLine3 string `json:"address_line3,omitempty"`
+		// City is the city of the creditor’s address.
+		City string `json:"city,omitempty"`
+		// Region is the creditor's address region, county or department
+		Region string `json:"region,omitempty"`
+		// PostalCode is the creditor's postal code
+		PostalCode string `json:"postal_code,omitempty"`
+		// CountryCode is the ISO 3166-1 alpha-2 code.
+		CountryCode string `json:"country_code,omitempty"`
+		// CreditorType the type of business of the creditor, e.g. company, individual or charity
+		CreditorType string `json:"creditor_type,omitempty"`
+		// LogoURL URL of the creditor’s logo, shown on the payment pages
+		LogoURL string `json:"logo_url,omitempty"`
+		// VerificationStatus whether the creditor has been verified and can receive payouts, e.g. successful
+		VerificationStatus string `json:"verification_status,omitempty"`
+		// CanCreateRefunds whether the creditor can create refunds through the API
+		CanCreateRefunds bool `json:"can_create_refunds,omitempty"`
+		// MandateImportsEnabled whether the creditor can import mandates from another provider
+		MandateImportsEnabled bool `json:"mandate_imports_enabled,omitempty"`
+		// SchemeIdentifiers the identifiers of the creditor in each Direct Debit scheme
+		SchemeIdentifiers []*SchemeIdentifier `json:"scheme_identifiers,omitempty"`
+		// Links to the default payout account of each currency
+		Links creditorLinks `json:"links"`
+	}
+	creditorLinks struct {
+		DefaultAUDPayoutAccount string `json:"default_aud_payout_account,omitempty"`
+		DefaultCADPayoutAccount string `json:"default_cad_payout_account,omitempty"`
+		DefaultDKKPayoutAccount string `json:"default_dkk_payout_account,omitempty"`
+		DefaultEURPayoutAccount string `json:"default_eur_payout_account,omitempty"`
+		DefaultGBPPayoutAccount string `json:"default_gbp_payout_account,omitempty"`
+		DefaultNZDPayoutAccount string `json:"default_nzd_payout_account,omitempty"`
+		DefaultSEKPayoutAccount string `json:"default_sek_payout_account,omitempty"`
+		DefaultUSDPayoutAccount string `json:"default_usd_payout_account,omitempty"`
+	}
+	// SchemeIdentifier the details a creditor is known by in a Direct Debit scheme, e.g. the Bacs service user number
+	SchemeIdentifier struct {
+		Name                       string `json:"name,omitempty"`
+		Scheme                     string `json:"scheme,omitempty"`
+		Reference                  string `json:"reference,omitempty"`
+		MinimumAdvanceNotice       int    `json:"minimum_advance_notice,omitempty"`
+		CanSpecifyMandateReference bool   `json:"can_specify_mandate_reference,omitempty"`
+		Currency                   string `json:"currency,omitempty"`
+		Email                      string `json:"email,omitempty"`
+		PhoneNumber                string `json:"phone_number,omitempty"`
+		AddressLine1               string `json:"address_line1,omitempty"`
+		AddressLine2               string `json:"address_line2,omitempty"`
+		AddressLine3               string `json:"address_line3,omitempty"`
+		City                       string `json:"city,omitempty"`
+		Region                     string `json:"region,omitempty"`
+		PostalCode                 string `json:"postal_code,omitempty"`
+		CountryCode                string `json:"country_code,omitempty"`
+	}
+	// creditorWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	creditorWrapper struct {
+		Creditor *Creditor `json:"creditors"`
+	}
+
+	// CreditorListResponse a List response of Creditor instances
+	CreditorListResponse struct {
+		Creditors []*Creditor `json:"creditors"`
+		Meta      Meta        `json:"meta,omitempty"`
+	}
+
+	// CreditorListParams parameters to filter and paginate the list of creditors
+	CreditorListParams struct {
+		ListParams
+		// CreatedAt filters creditors by creation time
+		CreatedAt TimeRange
+	}
+
+	// CreditorIterator iterates over a list of creditors, fetching further pages as needed
+	CreditorIterator struct {
+		pager
+		page []*Creditor
+	}
+)
+
+func (cr *Creditor) String() string {
+	bs, _ := json.Marshal(cr)
+	return string(bs)
+}
+
+// NewCreditor instantiate new creditor object
+func NewCreditor(name, line1, city, postalCode, countryCode string) *Creditor {
+	return &Creditor{
+		Name:         name,
+		AddressLine1: line1,
+		City:         city,
+		PostalCode:   postalCode,
+		CountryCode:  countryCode,
+	}
+}
+
+// CreateCreditor creates a new creditor object.
+// Restricted in the live environment unless your account is approved as a whitelabel partner, see LiveEnvironment
+//
+// Relative endpoint: POST /creditors
+func (c *Client) CreateCreditor(ctx context.Context, creditor *Creditor) error {
+	creditorReq := &creditorWrapper{creditor}
+
+	err := c.create(ctx, creditorEndpoint, creditorReq, creditorReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// GetCreditors returns a cursor-paginated list of your creditors.
+//
+// Relative endpoint: GET /creditors
+func (c *Client) GetCreditors(ctx context.Context) (*CreditorListResponse, error) {
+	return c.GetCreditorsWithParams(ctx, nil)
+}
+
+// GetCreditorsWithParams is GetCreditors with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /creditors
+func (c *Client) GetCreditorsWithParams(ctx context.Context, params *CreditorListParams) (*CreditorListResponse, error) {
+	list := &CreditorListResponse{}
+
+	err := c.get(ctx, listPath(creditorEndpoint, params.values()), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *CreditorListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	return query
+}
+
+// Creditors returns an iterator over all your creditors matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /creditors
+func (c *Client) Creditors(ctx context.Context, params *CreditorListParams) *CreditorIterator {
+	it := &CreditorIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &CreditorListResponse{}
+		if err := c.get(ctx, listPath(creditorEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Creditors
+		return len(list.Creditors), list.Meta, nil
+	})
+	return it
+}
+
+// Creditor returns the current creditor, or nil unless the last call to Next returned true
+func (it *CreditorIterator) Creditor() *Creditor {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// GetCreditor retrieves the details of an existing creditor.
+//
+// Relative endpoint: GET /creditors/CR123
+func (c *Client) GetCreditor(ctx context.Context, id string) (*Creditor, error) {
+	wrapper := &creditorWrapper{}
+
+	err := c.get(ctx, fmt.Sprintf(`%s/%s`, creditorEndpoint, id), wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.Creditor, err
+}
+
+// UpdateCreditor Updates a creditor object. Supports the creditor details and default payout accounts.
+//
+// Relative endpoint: PUT /creditors/CR123
+func (c *Client) UpdateCreditor(ctx context.Context, creditor *Creditor) error {
+	// allows only the creditor details and links, leaving out the unset ones so they are not cleared
+	details := nonEmpty(map[string]string{
+		"name":          creditor.Name,
+		"address_line1": creditor.AddressLine1,
+		"address_line2": creditor.AddressLine2,
+		"address_line3": creditor.AddressLine3,
+		"city":          creditor.City,
+		"region":        creditor.Region,
+		"postal_code":   creditor.PostalCode,
+		"country_code":  creditor.CountryCode,
+	})
+	if creditor.Links != (creditorLinks{}) {
+		details["links"] = creditor.Links
+	}
+	creditorData := map[string]interface{}{
+		"creditors": details,
+	}
+
+	creditorReq := &creditorWrapper{creditor}
+
+	err := c.put(ctx, fmt.Sprintf(`%s/%s`, creditorEndpoint, creditor.ID), creditorData, creditorReq)
+	if err != nil {
+		return err
+	}
+	return err
+}
@@ -0,0 +1,205 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	creditorBankAccountEndpoint = "creditor_bank_accounts"
+)
+
+type (
+	// CreditorBankAccount Creditor Bank Accounts hold the bank details of a creditor.
+	// These are the bank accounts which your payouts will be sent to.
+	CreditorBankAccount struct {
+		// ID is a unique identifier, beginning with "BA".
+		ID string `json:"id,omitempty"`
+		// CreatedAt is a fixed timestamp, recording when the bank account was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// AccountHolderName Name of the account holder, as known by the bank.
+		AccountHolderName string `json:"account_holder_name"`
+		// AccountNumber Bank account number. Alternatively you can provide an iban
+		AccountNumber string `json:"account_number,omitempty"`
+		// AccountType Bank account type, only required for USD denominated bank accounts
+		AccountType string `json:"account_type,omitempty"`
+		// BankCode Bank code
+		BankCode string `json:"bank_code,omitempty"`
+		// BranchCode Branch code
+		BranchCode string `json:"branch_code,omitempty"`
+		// AccountNumberEnding Last two digits of account number
+		AccountNumberEnding string `json:"account_number_ending,omitempty"`
+		// BankName Name of bank, taken from the bank details
+		BankName string `json:"bank_name,omitempty"`
+		// CountryCode is the ISO 3166-1 alpha-2 code.
+		CountryCode string `json:"country_code,omitempty"`
+		// Currency currency code, defaults to national currency of country_code
+		Currency string `json:"currency,omitempty"`
+		// IBAN International Bank Account Number
+		IBAN string `json:"iban,omitempty"`
+		// SetAsDefaultPayoutAccount Defaults to false. When this is set to true, it will cause this bank account
+		// to be set as the account that GoCardless will pay out to
+		SetAsDefaultPayoutAccount bool `json:"set_as_default_payout_account,omitempty"`
+		// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+		// characters and values up to 500 characters.
+		Metadata map[string]string `json:"metadata,omitempty"`
+		// Links links contains the creditor id
+		Links creditorBankAccountLinks `json:"links"`
+		// Enabled indicates if bank account is disabled
+		Enabled bool `json:"enabled,omitempty"`
+	}
+	creditorBankAccountLinks struct {
+		// CreditorID ID of the creditor that owns this bank account
+		CreditorID string `json:"creditor"`
+	}
+	// creditorBankAccountWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	creditorBankAccountWrapper struct {
+		CreditorBankAccount *CreditorBankAccount `json:"creditor_bank_accounts"`
+	}
+
+	// CreditorBankAccountListResponse a List response of CreditorBankAccount instances
+	CreditorBankAccountListResponse struct {
+		CreditorBankAccounts []*CreditorBankAccount `json:"creditor_bank_accounts"`
+		Meta                 Meta                   `json:"meta,omitempty"`
+	}
+
+	// CreditorBankAccountListParams parameters to filter and paginate the list of creditor bank accounts
+	CreditorBankAccountListParams struct {
+		ListParams
+		// CreatedAt filters bank accounts by creation time
+		CreatedAt TimeRange
+		// Creditor only returns bank accounts of this creditor ID
+		Creditor string
+		// Enabled only returns enabled or disabled bank accounts when set
+		Enabled *bool
+	}
+
+	// CreditorBankAccountIterator iterates over a list of creditor bank accounts, fetching further pages as needed
+	CreditorBankAccountIterator struct {
+		pager
+		page []*CreditorBankAccount
+	}
+)
+
+func (ba *CreditorBankAccount) String() string {
+	bs, _ := json.Marshal(ba)
+	return string(bs)
+}
+
+// NewCreditorBankAccount instantiate a new creditor bank account object
+func NewCreditorBankAccount(accountNumber, accountName, branchCode, countryCode, creditorID string) *CreditorBankAccount {
+	return &CreditorBankAccount{
+		AccountNumber:     accountNumber,
+		BranchCode:        branchCode,
+		AccountHolderName: accountName,
+		CountryCode:       countryCode,
+		Links:             creditorBankAccountLinks{CreditorID: creditorID},
+	}
+}
+
+// AddMetadata adds new metadata item to creditor bank account object
+func (ba *CreditorBankAccount) AddMetadata(key, value string) {
+	if ba.Metadata == nil {
+		ba.Metadata = make(map[string]string)
+	}
+	ba.Metadata[key] = value
+}
+
+// CreateCreditorBankAccount creates a new creditor bank account object.
+//
+// Relative endpoint: POST /creditor_bank_accounts
+func (c *Client) CreateCreditorBankAccount(ctx context.Context, ba *CreditorBankAccount) error {
+	baReq := &creditorBankAccountWrapper{ba}
+
+	err := c.create(ctx, creditorBankAccountEndpoint, baReq, baReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// GetCreditorBankAccounts returns a cursor-paginated list of your creditor bank accounts.
+//
+// Relative endpoint: GET /creditor_bank_accounts
+func (c *Client) GetCreditorBankAccounts(ctx context.Context) (*CreditorBankAccountListResponse, error) {
+	return c.GetCreditorBankAccountsWithParams(ctx, nil)
+}
+
+// GetCreditorBankAccountsWithParams is GetCreditorBankAccounts with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /creditor_bank_accounts
+func (c *Client) GetCreditorBankAccountsWithParams(ctx context.Context, params *CreditorBankAccountListParams) (*CreditorBankAccountListResponse, error) {
+	list := &CreditorBankAccountListResponse{}
+
+	err := c.get(ctx, listPath(creditorBankAccountEndpoint, params.values()), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *CreditorBankAccountListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "creditor", params.Creditor)
+	setBool(query, "enabled", params.Enabled)
+	return query
+}
+
+// CreditorBankAccounts returns an iterator over all your creditor bank accounts matching params,
+// following the pagination cursors. params may be nil.
+//
+// Relative endpoint: GET /creditor_bank_accounts
+func (c *Client) CreditorBankAccounts(ctx context.Context, params *CreditorBankAccountListParams) *CreditorBankAccountIterator {
+	it := &CreditorBankAccountIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &CreditorBankAccountListResponse{}
+		if err := c.get(ctx, listPath(creditorBankAccountEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.CreditorBankAccounts
+		return len(list.CreditorBankAccounts), list.Meta, nil
+	})
+	return it
+}
+
+// CreditorBankAccount returns the current creditor bank account, or nil unless the last call to Next returned true
+func (it *CreditorBankAccountIterator) CreditorBankAccount() *CreditorBankAccount {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// GetCreditorBankAccount Retrieves the details of an existing creditor bank account.
+//
+// Relative endpoint: GET /creditor_bank_accounts/BA123
+func (c *Client) GetCreditorBankAccount(ctx context.Context, id string) (*CreditorBankAccount, error) {
+	wrapper := &creditorBankAccountWrapper{}
+
+	err := c.get(ctx, fmt.Sprintf(`%s/%s`, creditorBankAccountEndpoint, id), wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.CreditorBankAccount, err
+}
+
+// DisableCreditorBankAccount disables a creditor bank account, it can no longer be used for payouts
+//
+// Relative endpoint: POST /creditor_bank_accounts/BA123/actions/disable
+func (c *Client) DisableCreditorBankAccount(ctx context.Context, id string) (*CreditorBankAccount, error) {
+	wrapper := &creditorBankAccountWrapper{}
+	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/disable`, creditorBankAccountEndpoint, id), nil, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.CreditorBankAccount, err
+}
@@ -0,0 +1,39 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateCreditorOmitsBlankFields(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"creditors":{"id":"CR123","name":"Acme"}}`))
+
+	if err := c.UpdateCreditor(context.Background(), &Creditor{ID: "CR123", Name: "Acme"}); err != nil {
+		t.Fatal(err)
+	}
+	var req struct {
+		Creditors map[string]interface{} `json:"creditors"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.Creditors) != 1 || req.Creditors["name"] != "Acme" {
+		t.Errorf("sent %s, want only the name", body)
+	}
+
+	creditor := &Creditor{ID: "CR123", City: "London"}
+	creditor.Links.DefaultGBPPayoutAccount = "BA123"
+	if err := c.UpdateCreditor(context.Background(), creditor); err != nil {
+		t.Fatal(err)
+	}
+	req.Creditors = nil
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	links, _ := req.Creditors["links"].(map[string]interface{})
+	if len(req.Creditors) != 2 || req.Creditors["city"] != "London" || len(links) != 1 || links["default_gbp_payout_account"] != "BA123" {
+		t.Errorf("sent %s, want only the city and the GBP payout account", body)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -64,6 +65,21 @@ type (
 		Customers []*Customer `json:"customers"`
 		Meta      Meta        `json:"meta,omitempty"`
 	}
+
+	// CustomerListParams parameters to filter and paginate the list of customers
+	CustomerListParams struct {
+		ListParams
+		// CreatedAt filters customers by creation time
+		CreatedAt TimeRange
+		// Currency only returns customers with bank accounts in this currency
+		Currency string
+	}
+
+	// CustomerIterator iterates over a list of customers, fetching further pages as needed
+	CustomerIterator struct {
+		pager
+		page []*Customer
+	}
 )
 
 func (cm *Customer) String() string {
@@ -96,7 +112,7 @@ func (cm *Customer) AddMetadata(key, value string) {
 func (c *Client) CreateCustomer(ctx context.Context, customer *Customer) error {
 	customerReq := &customerWrapper{customer}
 
-	err := c.post(ctx, customerEndpoint, customerReq, customerReq)
+	err := c.create(ctx, customerEndpoint, customerReq, customerReq)
 	if err != nil {
 		return err
 	}
@@ -108,15 +124,58 @@ func (c *Client) CreateCustomer(ctx context.Context, customer *Customer) error {
 //
 // Relative endpoint: GET /customers
 func (c *Client) GetCustomers(ctx context.Context) (*CustomerListResponse, error) {
+	return c.GetCustomersWithParams(ctx, nil)
+}
+
+// GetCustomersWithParams is GetCustomers with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /customers
+func (c *Client) GetCustomersWithParams(ctx context.Context, params *CustomerListParams) (*CustomerListResponse, error) {
 	list := &CustomerListResponse{}
 
-	err := c.get(ctx, customerEndpoint, list)
+	err := c.get(ctx, listPath(customerEndpoint, params.values()), list)
 	if err != nil {
 		return nil, err
 	}
 	return list, err
 }
 
+func (params *CustomerListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "currency", params.Currency)
+	return query
+}
+
+// Customers returns an iterator over all your customers matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /customers
+func (c *Client) Customers(ctx context.Context, params *CustomerListParams) *CustomerIterator {
+	it := &CustomerIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &CustomerListResponse{}
+		if err := c.get(ctx, listPath(customerEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Customers
+		return len(list.Customers), list.Meta, nil
+	})
+	return it
+}
+
+// Customer returns the current customer, or nil unless the last call to Next returned true
+func (it *CustomerIterator) Customer() *Customer {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
 // GetCustomer retrieves the details of an existing customer.
 //
 // Relative endpoint: GET /customers/CU123
@@ -147,3 +206,21 @@ func (c *Client) UpdateCustomer(ctx context.Context, customer *Customer) error {
 	}
 	return err
 }
+
+// RemoveCustomer removes a customer and erases their personal data, e.g. to comply with a GDPR erasure request.
+// The customer's mandates must be cancelled first, otherwise an error matching ErrCustomerHasActiveMandates
+// is returned. Removal is permanent and the customer can no longer be retrieved.
+//
+// Relative endpoint: DELETE /customers/CU123
+func (c *Client) RemoveCustomer(ctx context.Context, id string) error {
+	// the endpoint requires an empty data object
+	cmData := map[string]interface{}{
+		"data": map[string]interface{}{},
+	}
+
+	err := c.delete(ctx, fmt.Sprintf(`%s/%s`, customerEndpoint, id), cmData)
+	if err != nil {
+		return err
+	}
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"context"
 	"fmt"
+	"net/url"
 )
 
 const (
@@ -60,6 +61,23 @@ type (
 		CustomerBankAccounts []*CustomerBankAccount `json:"customer_bank_accounts"`
 		Meta                 Meta                   `json:"meta,omitempty"`
 	}
+
+	// CustomerBankAccountListParams parameters to filter and paginate the list of customer bank accounts
+	CustomerBankAccountListParams struct {
+		ListParams
+		// CreatedAt filters bank accounts by creation time
+		CreatedAt TimeRange
+		// Customer only returns bank accounts of this customer ID
+		Customer string
+		// Enabled only returns enabled or disabled bank accounts when set
+		Enabled *bool
+	}
+
+	// CustomerBankAccountIterator iterates over a list of customer bank accounts, fetching further pages as needed
+	CustomerBankAccountIterator struct {
+		pager
+		page []*CustomerBankAccount
+	}
 )
 
 func (ca *CustomerBankAccount) String() string {
@@ -89,7 +107,7 @@ func (ca *CustomerBankAccount) AddMetadata(key, value string) {
 func (c *Client) CreateCustomerBankAccount(ctx context.Context, cba *CustomerBankAccount) error {
 	cbaReq := &customerBankAccountWrapper{cba}
 
-	err := c.post(ctx, bankAccountEndpoint, cbaReq, cbaReq)
+	err := c.create(ctx, bankAccountEndpoint, cbaReq, cbaReq)
 	if err != nil {
 		return err
 	}
@@ -101,15 +119,59 @@ func (c *Client) CreateCustomerBankAccount(ctx context.Context, cba *CustomerBan
 //
 // Relative endpoint: GET /customer_bank_accounts
 func (c *Client) GetCustomerBankAccounts(ctx context.Context) (*CustomerBankAccountListResponse, error) {
+	return c.GetCustomerBankAccountsWithParams(ctx, nil)
+}
+
+// GetCustomerBankAccountsWithParams is GetCustomerBankAccounts with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /customer_bank_accounts
+func (c *Client) GetCustomerBankAccountsWithParams(ctx context.Context, params *CustomerBankAccountListParams) (*CustomerBankAccountListResponse, error) {
 	list := &CustomerBankAccountListResponse{}
 
-	err := c.get(ctx, bankAccountEndpoint, list)
+	err := c.get(ctx, listPath(bankAccountEndpoint, params.values()), list)
 	if err != nil {
 		return nil, err
 	}
 	return list, err
 }
 
+func (params *CustomerBankAccountListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "customer", params.Customer)
+	setBool(query, "enabled", params.Enabled)
+	return query
+}
+
+// CustomerBankAccounts returns an iterator over all your customer bank accounts matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /customer_bank_accounts
+func (c *Client) CustomerBankAccounts(ctx context.Context, params *CustomerBankAccountListParams) *CustomerBankAccountIterator {
+	it := &CustomerBankAccountIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &CustomerBankAccountListResponse{}
+		if err := c.get(ctx, listPath(bankAccountEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.CustomerBankAccounts
+		return len(list.CustomerBankAccounts), list.Meta, nil
+	})
+	return it
+}
+
+// CustomerBankAccount returns the current customer bank account, or nil unless the last call to Next returned true
+func (it *CustomerBankAccountIterator) CustomerBankAccount() *CustomerBankAccount {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
 // GetCustomerBankAccount Retrieves the details of an existing bank account.
 //
 // Relative endpoint: GET /customer_bank_accounts/BA123
@@ -1,14 +1,82 @@
 package gocardless
 
 import (
-	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 )
 
 const (
 	// InvalidMethodError details when a request is passed, but the method is invalid in the current context
 	InvalidMethodError = `The request Method is invalid`
+
+	// reasonIdempotentCreationConflict is returned when a resource was already created with the same idempotency key
+	reasonIdempotentCreationConflict = `idempotent_creation_conflict`
+)
+
+// Error types returned in the type field of API errors
+//
+// https://developer.gocardless.com/api-reference/#overview-errors
+const (
+	// ErrorTypeGoCardless an internal error occurred while GoCardless was processing the request
+	ErrorTypeGoCardless = `gocardless`
+	// ErrorTypeInvalidAPIUsage the request was invalid, e.g. malformed or rate limited
+	ErrorTypeInvalidAPIUsage = `invalid_api_usage`
+	// ErrorTypeInvalidState the action can not be performed on the resource in its current state
+	ErrorTypeInvalidState = `invalid_state`
+	// ErrorTypeValidationFailed the parameters of the request failed validation
+	ErrorTypeValidationFailed = `validation_failed`
 )
 
+// Sentinel errors matching API errors with errors.Is, e.g.
+//
+//	if errors.Is(err, gocardless.ErrMandateIsInactive) { ... }
+var (
+	// ErrGoCardless matches errors of type gocardless
+	ErrGoCardless = errors.New("gocardless: internal error")
+	// ErrInvalidAPIUsage matches errors of type invalid_api_usage
+	ErrInvalidAPIUsage = errors.New("gocardless: invalid api usage")
+	// ErrInvalidState matches errors of type invalid_state
+	ErrInvalidState = errors.New("gocardless: invalid state")
+	// ErrValidationFailed matches errors of type validation_failed
+	ErrValidationFailed = errors.New("gocardless: validation failed")
+
+	// ErrMandateIsInactive matches errors with the mandate_is_inactive reason
+	ErrMandateIsInactive = errors.New("gocardless: mandate is inactive")
+	// ErrCustomerHasActiveMandates matches errors with the customer_has_active_mandates reason,
+	// returned when removing a customer whose mandates are not all cancelled
+	ErrCustomerHasActiveMandates = errors.New("gocardless: customer has active mandates")
+	// ErrBankAccountDisabled matches errors with the bank_account_disabled reason
+	ErrBankAccountDisabled = errors.New("gocardless: bank account disabled")
+	// ErrRateLimitExceeded matches errors with the rate_limit_exceeded reason and RateLimitedExceededError
+	ErrRateLimitExceeded = errors.New("gocardless: rate limit exceeded")
+	// ErrIdempotentCreationConflict matches errors with the idempotent_creation_conflict reason
+	ErrIdempotentCreationConflict = errors.New("gocardless: idempotent creation conflict")
+)
+
+// ErrInvalidSignature is returned when the Webhook-Signature header of a webhook does not match its body,
+// the request did not come from GoCardless or was signed with another secret
+var ErrInvalidSignature = errors.New("gocardless: invalid webhook signature")
+
+// errorTypes maps the sentinel errors to the error type they match
+var errorTypes = map[error]string{
+	ErrGoCardless:       ErrorTypeGoCardless,
+	ErrInvalidAPIUsage:  ErrorTypeInvalidAPIUsage,
+	ErrInvalidState:     ErrorTypeInvalidState,
+	ErrValidationFailed: ErrorTypeValidationFailed,
+}
+
+// errorReasons maps the sentinel errors to the error reason they match
+var errorReasons = map[error]string{
+	ErrMandateIsInactive:          `mandate_is_inactive`,
+	ErrCustomerHasActiveMandates:  `customer_has_active_mandates`,
+	ErrBankAccountDisabled:        `bank_account_disabled`,
+	ErrRateLimitExceeded:          `rate_limit_exceeded`,
+	ErrIdempotentCreationConflict: reasonIdempotentCreationConflict,
+}
+
 type errorContainer struct {
 	Error *Error `json:"error"`
 }
@@ -25,8 +93,65 @@ type Error struct {
 }
 
 func (err Error) Error() string {
-	data, _ := json.Marshal(err)
-	return string(data)
+	// an error without a message is described by its HTTP status, or else by its type
+	message := err.Message
+	if message == "" {
+		message = http.StatusText(err.Code)
+	}
+	if message == "" {
+		message = err.Type
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "gocardless: %s", message)
+	if err.Type != "" && message != err.Type {
+		fmt.Fprintf(&b, " (%s, %d)", err.Type, err.Code)
+	}
+
+	details := make([]string, 0, len(err.Details))
+	for _, detail := range err.Details {
+		details = append(details, detail.String())
+	}
+	if len(details) > 0 {
+		fmt.Fprintf(&b, ": %s", strings.Join(details, "; "))
+	}
+
+	if err.RequestID != "" {
+		fmt.Fprintf(&b, " [request_id: %s]", err.RequestID)
+	}
+	return b.String()
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package, e.g. ErrValidationFailed
+func (err Error) Is(target error) bool {
+	if errorType, ok := errorTypes[target]; ok {
+		return err.Type == errorType
+	}
+	if reason, ok := errorReasons[target]; ok {
+		return err.HasReason(reason)
+	}
+	return false
+}
+
+// HasReason reports whether any of the error details has the given reason
+func (err Error) HasReason(reason string) bool {
+	for _, detail := range err.Details {
+		if detail.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// ConflictingResourceID returns the ID of the resource previously created with the same idempotency key,
+// or an empty string when the error is not an idempotent creation conflict
+func (err Error) ConflictingResourceID() string {
+	for _, detail := range err.Details {
+		if detail.Reason == reasonIdempotentCreationConflict && detail.Links.ConflictingResourceID != "" {
+			return detail.Links.ConflictingResourceID
+		}
+	}
+	return ""
 }
 
 // ErrorDetail a struct containing the reason for the errors
@@ -34,15 +159,57 @@ type ErrorDetail struct {
 	Message        string `json:"message"`
 	Field          string `json:"field"`
 	RequestPointer string `json:"request_pointer"`
+	// Reason a machine readable code for the error, e.g. idempotent_creation_conflict
+	Reason string `json:"reason,omitempty"`
+	// Links links to the resources involved in the error
+	Links errorLinks `json:"links,omitempty"`
+}
+type errorLinks struct {
+	// ConflictingResourceID ID of the resource already created with the same idempotency key
+	ConflictingResourceID string `json:"conflicting_resource_id,omitempty"`
+}
+
+func (detail *ErrorDetail) String() string {
+	if detail.Field != "" {
+		return fmt.Sprintf("%s %s", detail.Field, detail.Message)
+	}
+	return detail.Message
 }
 
-// RateLimitedExceededError rate limit error
+// RateLimitedExceededError rate limit error, returned when a request is still rate limited
+// after the retries allowed by the client's RetryPolicy
 type RateLimitedExceededError struct {
+	// Reset the time after which the rate limit resets, zero when unknown
+	Reset time.Time
+	// Err the error returned by the API, if any
+	Err *Error
 }
 
 func (err *RateLimitedExceededError) Error() string {
-	return `Rate Limit exceeded`
+	if err.Reset.IsZero() {
+		return `Rate Limit exceeded`
+	}
+	return fmt.Sprintf("Rate Limit exceeded, resets at %s", err.Reset.Format(time.RFC1123))
+}
+
+// Is matches ErrRateLimitExceeded
+func (err *RateLimitedExceededError) Is(target error) bool {
+	return target == ErrRateLimitExceeded
+}
+
+// Unwrap returns the error returned by the API
+func (err *RateLimitedExceededError) Unwrap() error {
+	if err.Err == nil {
+		return nil
+	}
+	return err.Err
 }
 
 // InvalidEnvironment invalid environment exception
-type InvalidEnvironment error
+type InvalidEnvironment struct {
+	Environment Environment
+}
+
+func (err *InvalidEnvironment) Error() string {
+	return fmt.Sprintf("Invalid environment %s, use one of (%s, %s)", err.Environment, SandboxEnvironment, LiveEnvironment)
+}
@@ -0,0 +1,100 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestErrorIs(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *Error
+		target error
+		want   bool
+	}{
+		{"gocardless", &Error{Type: ErrorTypeGoCardless}, ErrGoCardless, true},
+		{"invalid api usage", &Error{Type: ErrorTypeInvalidAPIUsage}, ErrInvalidAPIUsage, true},
+		{"invalid state", &Error{Type: ErrorTypeInvalidState}, ErrInvalidState, true},
+		{"validation failed", &Error{Type: ErrorTypeValidationFailed}, ErrValidationFailed, true},
+		{"other type", &Error{Type: ErrorTypeInvalidState}, ErrValidationFailed, false},
+		{"mandate is inactive", withReason(ErrorTypeInvalidState, "mandate_is_inactive"), ErrMandateIsInactive, true},
+		{"customer has active mandates", withReason(ErrorTypeInvalidState, "customer_has_active_mandates"), ErrCustomerHasActiveMandates, true},
+		{"bank account disabled", withReason(ErrorTypeInvalidState, "bank_account_disabled"), ErrBankAccountDisabled, true},
+		{"rate limit exceeded", withReason(ErrorTypeInvalidAPIUsage, "rate_limit_exceeded"), ErrRateLimitExceeded, true},
+		{"idempotent creation conflict", withReason(ErrorTypeInvalidState, "idempotent_creation_conflict"), ErrIdempotentCreationConflict, true},
+		{"other reason", withReason(ErrorTypeInvalidState, "mandate_is_inactive"), ErrBankAccountDisabled, false},
+		{"unrelated sentinel", &Error{Type: ErrorTypeInvalidState}, ErrInvalidSignature, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapped := fmt.Errorf("creating payment: %w", tt.err)
+			if got := errors.Is(wrapped, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%v, %v) = %t, want %t", wrapped, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+// withReason returns an error of errorType with a single detail of reason
+func withReason(errorType, reason string) *Error {
+	return &Error{Type: errorType, Details: []*ErrorDetail{{Reason: reason, Message: reason}}}
+}
+
+func TestErrorAs(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error":{"message":"Validation failed","type":"validation_failed","code":422,` +
+			`"request_id":"RQ123","errors":[{"field":"amount","message":"is required"}]}}`))
+	}))
+
+	err := c.CreatePayment(context.Background(), NewPayment(0, "GBP", "MD123"))
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As(%v, *Error) = false, want true", err)
+	}
+	if apiErr.Code != 422 || apiErr.RequestID != "RQ123" || !errors.Is(err, ErrValidationFailed) {
+		t.Errorf("got error %#v", apiErr)
+	}
+	want := "gocardless: Validation failed (validation_failed, 422): amount is required [request_id: RQ123]"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  Error
+		want string
+	}{
+		{"message", Error{Message: "Invalid state", Type: ErrorTypeInvalidState, Code: 422}, "gocardless: Invalid state (invalid_state, 422)"},
+		{"status text", Error{Type: ErrorTypeValidationFailed, Code: 422}, "gocardless: Unprocessable Entity (validation_failed, 422)"},
+		{"type", Error{Type: ErrorTypeValidationFailed}, "gocardless: validation_failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorNotFromGoCardless(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`<html><body>502 Bad Gateway</body></html>`))
+	}))
+
+	_, err := c.GetPayment(context.Background(), "PM123")
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusBadGateway {
+		t.Fatalf("got error %v, want an *Error with code 502", err)
+	}
+	if want := "gocardless: Bad Gateway"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
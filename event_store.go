@@ -0,0 +1,195 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEventTTL how long a WebhookHandler remembers processed events when its EventTTL is not set,
+	// well beyond the period over which GoCardless retries a webhook
+	DefaultEventTTL = 30 * 24 * time.Hour
+
+	// DefaultClaimTTL how long a WebhookHandler claims an event while its handlers run when its ClaimTTL is not set
+	DefaultClaimTTL = 5 * time.Minute
+)
+
+// EventStore records the IDs of claimed and processed events, so a WebhookHandler runs its handlers only once per event
+// although GoCardless delivers webhooks at least once and retries failed batches
+type EventStore interface {
+	// Claim atomically reserves the event with id for processing until ttl has elapsed.
+	// It returns false when the event is already claimed or processed, the caller must then skip it
+	Claim(ctx context.Context, id string, ttl time.Duration) (bool, error)
+	// Release gives up the claim on the event with id after it could not be processed, so it can be claimed again
+	Release(ctx context.Context, id string) error
+	// MarkProcessed records the claimed event with id as processed, it may be forgotten once ttl has elapsed
+	MarkProcessed(ctx context.Context, id string, ttl time.Duration) error
+}
+
+// eventExpiries maps the IDs of claimed and processed events to the time they expire, a zero time never expires
+type eventExpiries map[string]time.Time
+
+func (e eventExpiries) seen(id string, now time.Time) bool {
+	expiry, ok := e[id]
+	return ok && (expiry.IsZero() || now.Before(expiry))
+}
+
+func (e eventExpiries) mark(id string, ttl time.Duration, now time.Time) {
+	var expiry time.Time
+	if ttl > 0 {
+		expiry = now.Add(ttl)
+	}
+	e[id] = expiry
+}
+
+// claim marks id until ttl has elapsed unless it is already marked, reporting whether it did
+func (e eventExpiries) claim(id string, ttl time.Duration, now time.Time) bool {
+	if e.seen(id, now) {
+		return false
+	}
+	e.purge(now)
+	e.mark(id, ttl, now)
+	return true
+}
+
+// purge removes the expired events
+func (e eventExpiries) purge(now time.Time) {
+	for id, expiry := range e {
+		if !expiry.IsZero() && !now.Before(expiry) {
+			delete(e, id)
+		}
+	}
+}
+
+// MemoryEventStore an EventStore keeping the events in memory, they are forgotten when the process exits
+type MemoryEventStore struct {
+	mu     sync.Mutex
+	events eventExpiries
+}
+
+// NewMemoryEventStore creates an empty in-memory event store
+func NewMemoryEventStore() *MemoryEventStore {
+	return &MemoryEventStore{events: make(eventExpiries)}
+}
+
+// Claim reserves the event with id until ttl has elapsed, forever when ttl is zero,
+// unless it is already claimed or processed
+func (s *MemoryEventStore) Claim(ctx context.Context, id string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.events == nil {
+		s.events = make(eventExpiries)
+	}
+	return s.events.claim(id, ttl, time.Now()), nil
+}
+
+// Release removes the claim on the event with id
+func (s *MemoryEventStore) Release(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.events, id)
+	return nil
+}
+
+// MarkProcessed records the event with id as processed until ttl has elapsed, forever when ttl is zero
+func (s *MemoryEventStore) MarkProcessed(ctx context.Context, id string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.events == nil {
+		s.events = make(eventExpiries)
+	}
+	s.events.purge(now)
+	s.events.mark(id, ttl, now)
+	return nil
+}
+
+// FileEventStore an EventStore keeping the events in a JSON file, so they are remembered across restarts.
+// The file is rewritten on every change, it suits a single process handling a moderate volume of webhooks
+type FileEventStore struct {
+	path   string
+	mu     sync.Mutex
+	events eventExpiries
+}
+
+// NewFileEventStore creates an event store persisted to the file at path, loading the events it already holds.
+// The file is created on the first Claim if it does not exist
+func NewFileEventStore(path string) (*FileEventStore, error) {
+	s := &FileEventStore{
+		path:   path,
+		events: make(eventExpiries),
+	}
+
+	bs, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) > 0 {
+		if err := json.Unmarshal(bs, &s.events); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Claim reserves the event with id until ttl has elapsed, forever when ttl is zero,
+// unless it is already claimed or processed, and writes the store to its file.
+// The claim is persisted, so an event being processed when the process stopped is only processed again after ttl
+func (s *FileEventStore) Claim(ctx context.Context, id string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.events.claim(id, ttl, time.Now()) {
+		return false, nil
+	}
+	if err := s.save(); err != nil {
+		delete(s.events, id)
+		return false, err
+	}
+	return true, nil
+}
+
+// Release removes the claim on the event with id and writes the store to its file
+func (s *FileEventStore) Release(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.events, id)
+	return s.save()
+}
+
+// MarkProcessed records the event with id as processed until ttl has elapsed, forever when ttl is zero,
+// and writes the store to its file
+func (s *FileEventStore) MarkProcessed(ctx context.Context, id string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.events.purge(now)
+	s.events.mark(id, ttl, now)
+	return s.save()
+}
+
+// save writes the events to a temporary file then renames it, so the file is never left partially written
+func (s *FileEventStore) save() error {
+	bs, err := json.Marshal(s.events)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, bs, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,203 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testEventStores returns an empty store of each implementation
+func testEventStores(t *testing.T) map[string]EventStore {
+	t.Helper()
+
+	fileStore, err := NewFileEventStore(filepath.Join(t.TempDir(), "events.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]EventStore{
+		"memory": NewMemoryEventStore(),
+		"file":   fileStore,
+	}
+}
+
+func TestEventStoreConcurrentClaim(t *testing.T) {
+	for name, store := range testEventStores(t) {
+		t.Run(name, func(t *testing.T) {
+			var (
+				wg      sync.WaitGroup
+				claimed int32
+			)
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ok, err := store.Claim(context.Background(), "EV123", time.Minute)
+					if err != nil {
+						t.Error(err)
+					}
+					if ok {
+						atomic.AddInt32(&claimed, 1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if claimed != 1 {
+				t.Errorf("got %d claims, want 1", claimed)
+			}
+		})
+	}
+}
+
+func TestEventStoreClaim(t *testing.T) {
+	ctx := context.Background()
+	claim := func(t *testing.T, store EventStore, id string, ttl time.Duration, want bool) {
+		t.Helper()
+		ok, err := store.Claim(ctx, id, ttl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != want {
+			t.Errorf("Claim(%s) = %t, want %t", id, ok, want)
+		}
+	}
+
+	for name, store := range testEventStores(t) {
+		t.Run(name, func(t *testing.T) {
+			claim(t, store, "EV1", time.Minute, true)
+			claim(t, store, "EV1", time.Minute, false)
+
+			if err := store.Release(ctx, "EV1"); err != nil {
+				t.Fatal(err)
+			}
+			claim(t, store, "EV1", time.Minute, true)
+
+			if err := store.MarkProcessed(ctx, "EV1", time.Hour); err != nil {
+				t.Fatal(err)
+			}
+			claim(t, store, "EV1", time.Minute, false)
+
+			claim(t, store, "EV2", time.Millisecond, true)
+			time.Sleep(5 * time.Millisecond)
+			claim(t, store, "EV2", time.Minute, true)
+		})
+	}
+}
+
+func TestFileEventStorePersists(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.json")
+
+	store, err := NewFileEventStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Claim(ctx, "EV1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.MarkProcessed(ctx, "EV1", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := NewFileEventStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := reopened.Claim(ctx, "EV1", time.Minute); err != nil || ok {
+		t.Errorf("Claim() after reopening = %t, %v, want false", ok, err)
+	}
+}
+
+func TestWebhookHandlerConcurrentDeliveries(t *testing.T) {
+	h := NewWebhookHandler("secret")
+	h.Store = NewMemoryEventStore()
+
+	var handled int32
+	h.OnPayment("", func(ctx context.Context, event *Event) error {
+		atomic.AddInt32(&handled, 1)
+		// keep the event claimed while the other deliveries arrive
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if w := serveWebhook(h, http.MethodPost, testWebhookBatch, "secret"); w.Code != http.StatusNoContent {
+				t.Errorf("status = %d, want 204", w.Code)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if handled != 1 {
+		t.Errorf("handled the payment event %d times, want 1", handled)
+	}
+}
+
+func TestWebhookHandlerReleasesFailedEvents(t *testing.T) {
+	h := NewWebhookHandler("secret")
+	h.Store = NewMemoryEventStore()
+
+	calls := 0
+	h.OnPayment("", func(ctx context.Context, event *Event) error {
+		calls++
+		if calls == 1 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	for i, want := range []int{http.StatusInternalServerError, http.StatusNoContent, http.StatusNoContent} {
+		if w := serveWebhook(h, http.MethodPost, testWebhookBatch, "secret"); w.Code != want {
+			t.Errorf("delivery %d: status = %d, want %d", i+1, w.Code, want)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("handled the payment event %d times, want 2", calls)
+	}
+}
+
+// failingMarkStore is a MemoryEventStore which fails to mark events as processed
+type failingMarkStore struct {
+	*MemoryEventStore
+}
+
+func (s failingMarkStore) MarkProcessed(ctx context.Context, id string, ttl time.Duration) error {
+	return errors.New("store unavailable")
+}
+
+func TestWebhookHandlerMarkProcessedFailure(t *testing.T) {
+	h := NewWebhookHandler("secret")
+	h.Store = failingMarkStore{NewMemoryEventStore()}
+
+	var reported int
+	h.OnError = func(event *Event, err error) {
+		reported++
+	}
+	calls := 0
+	h.OnPayment("", func(ctx context.Context, event *Event) error {
+		calls++
+		return nil
+	})
+
+	for i := 0; i < 2; i++ {
+		if w := serveWebhook(h, http.MethodPost, testWebhookBatch, "secret"); w.Code != http.StatusNoContent {
+			t.Errorf("delivery %d: status = %d, want 204", i+1, w.Code)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("handled the payment event %d times, want 1", calls)
+	}
+	// both events of the batch fail to be marked on the first delivery
+	if reported != 2 {
+		t.Errorf("OnError called %d times, want 2", reported)
+	}
+}
@@ -0,0 +1,205 @@
+package gocardless
+
+// ResourceType the type of resource an Event is about, e.g. payments
+type ResourceType string
+
+// Action what happened to the resource of an Event, e.g. confirmed. The same action may apply to several resource types,
+// the constants are named after the resource type they are documented for
+type Action string
+
+// Cause what caused an Event, reported in its details, e.g. insufficient_funds
+type Cause string
+
+// Resource types of events
+//
+// https://developer.gocardless.com/api-reference/#event-types
+const (
+	ResourceTypeBillingRequests     ResourceType = "billing_requests"
+	ResourceTypeCreditors           ResourceType = "creditors"
+	ResourceTypeExports             ResourceType = "exports"
+	ResourceTypeInstalmentSchedules ResourceType = "instalment_schedules"
+	ResourceTypeMandates            ResourceType = "mandates"
+	ResourceTypeOrganisations       ResourceType = "organisations"
+	ResourceTypePayerAuthorisations ResourceType = "payer_authorisations"
+	ResourceTypePayments            ResourceType = "payments"
+	ResourceTypePayouts             ResourceType = "payouts"
+	ResourceTypeRefunds             ResourceType = "refunds"
+	ResourceTypeSchemeIdentifiers   ResourceType = "scheme_identifiers"
+	ResourceTypeSubscriptions       ResourceType = "subscriptions"
+)
+
+// Actions of payment events
+const (
+	ActionPaymentCreated                 Action = "created"
+	ActionPaymentCustomerApprovalGranted Action = "customer_approval_granted"
+	ActionPaymentCustomerApprovalDenied  Action = "customer_approval_denied"
+	ActionPaymentSubmitted               Action = "submitted"
+	ActionPaymentConfirmed               Action = "confirmed"
+	ActionPaymentPaidOut                 Action = "paid_out"
+	ActionPaymentCancelled               Action = "cancelled"
+	ActionPaymentFailed                  Action = "failed"
+	ActionPaymentLateFailureSettled      Action = "late_failure_settled"
+	ActionPaymentChargedBack             Action = "charged_back"
+	ActionPaymentChargebackCancelled     Action = "chargeback_cancelled"
+	ActionPaymentChargebackSettled       Action = "chargeback_settled"
+	ActionPaymentResubmissionRequested   Action = "resubmission_requested"
+	ActionPaymentSurchargeFeeDebited     Action = "surcharge_fee_debited"
+	ActionPaymentSurchargeFeeCredited    Action = "surcharge_fee_credited"
+)
+
+// Actions of mandate events
+const (
+	ActionMandateCreated                 Action = "created"
+	ActionMandateCustomerApprovalGranted Action = "customer_approval_granted"
+	ActionMandateCustomerApprovalSkipped Action = "customer_approval_skipped"
+	ActionMandateSubmitted               Action = "submitted"
+	ActionMandateActive                  Action = "active"
+	ActionMandateReinstated              Action = "reinstated"
+	ActionMandateTransferred             Action = "transferred"
+	ActionMandateReplaced                Action = "replaced"
+	ActionMandateConsumed                Action = "consumed"
+	ActionMandateBlocked                 Action = "blocked"
+	ActionMandateCancelled               Action = "cancelled"
+	ActionMandateFailed                  Action = "failed"
+	ActionMandateExpired                 Action = "expired"
+	ActionMandateResubmissionRequested   Action = "resubmission_requested"
+)
+
+// Actions of subscription events
+const (
+	ActionSubscriptionCreated                 Action = "created"
+	ActionSubscriptionCustomerApprovalGranted Action = "customer_approval_granted"
+	ActionSubscriptionCustomerApprovalDenied  Action = "customer_approval_denied"
+	ActionSubscriptionPaymentCreated          Action = "payment_created"
+	ActionSubscriptionAmended                 Action = "amended"
+	ActionSubscriptionPaused                  Action = "paused"
+	ActionSubscriptionResumed                 Action = "resumed"
+	ActionSubscriptionCancelled               Action = "cancelled"
+	ActionSubscriptionFinished                Action = "finished"
+)
+
+// Actions of payout events
+const (
+	ActionPayoutPaid                      Action = "paid"
+	ActionPayoutFxRateConfirmed           Action = "fx_rate_confirmed"
+	ActionPayoutTaxExchangeRatesConfirmed Action = "tax_exchange_rates_confirmed"
+)
+
+// Actions of refund events
+const (
+	ActionRefundCreated       Action = "created"
+	ActionRefundPaid          Action = "paid"
+	ActionRefundRefundSettled Action = "refund_settled"
+	ActionRefundFailed        Action = "failed"
+	ActionRefundFundsReturned Action = "funds_returned"
+)
+
+// Actions of instalment schedule events
+const (
+	ActionInstalmentScheduleCreated        Action = "created"
+	ActionInstalmentScheduleCreationFailed Action = "creation_failed"
+	ActionInstalmentScheduleCompleted      Action = "completed"
+	ActionInstalmentScheduleCancelled      Action = "cancelled"
+	ActionInstalmentScheduleErrored        Action = "errored"
+)
+
+// Actions of creditor events
+const (
+	ActionCreditorUpdated                   Action = "updated"
+	ActionCreditorNewPayoutCurrencyAdded    Action = "new_payout_currency_added"
+	ActionCreditorAccountAutoFrozen         Action = "account_auto_frozen"
+	ActionCreditorAccountAutoFrozenReverted Action = "account_auto_frozen_reverted"
+	ActionCreditorBouncedPayout             Action = "bounced_payout"
+)
+
+// Actions of billing request events
+const (
+	ActionBillingRequestCreated                     Action = "created"
+	ActionBillingRequestFlowCreated                 Action = "flow_created"
+	ActionBillingRequestFlowVisited                 Action = "flow_visited"
+	ActionBillingRequestFlowExited                  Action = "flow_exited"
+	ActionBillingRequestCollectCustomerDetails      Action = "collect_customer_details"
+	ActionBillingRequestCollectBankAccount          Action = "collect_bank_account"
+	ActionBillingRequestPayerDetailsConfirmed       Action = "payer_details_confirmed"
+	ActionBillingRequestBankAuthorisationVisited    Action = "bank_authorisation_visited"
+	ActionBillingRequestBankAuthorisationAuthorised Action = "bank_authorisation_authorised"
+	ActionBillingRequestBankAuthorisationDenied     Action = "bank_authorisation_denied"
+	ActionBillingRequestBankAuthorisationExpired    Action = "bank_authorisation_expired"
+	ActionBillingRequestBankAuthorisationFailed     Action = "bank_authorisation_failed"
+	ActionBillingRequestFulfilled                   Action = "fulfilled"
+	ActionBillingRequestCancelled                   Action = "cancelled"
+	ActionBillingRequestFailed                      Action = "failed"
+)
+
+// Causes of events
+//
+// https://developer.gocardless.com/api-reference/#event-types
+const (
+	CauseAuthorisationDisputed   Cause = "authorisation_disputed"
+	CauseBankAccountClosed       Cause = "bank_account_closed"
+	CauseBankAccountDisabled     Cause = "bank_account_disabled"
+	CauseBankAccountTransferred  Cause = "bank_account_transferred"
+	CauseChargebackCancelled     Cause = "chargeback_cancelled"
+	CauseChargebackSettled       Cause = "chargeback_settled"
+	CauseCustomerApprovalDenied  Cause = "customer_approval_denied"
+	CauseCustomerApprovalGranted Cause = "customer_approval_granted"
+	CauseCustomerApprovalSkipped Cause = "customer_approval_skipped"
+	CauseDirectDebitNotEnabled   Cause = "direct_debit_not_enabled"
+	CauseInsufficientFunds       Cause = "insufficient_funds"
+	CauseInvalidBankDetails      Cause = "invalid_bank_details"
+	CauseLateFailureSettled      Cause = "late_failure_settled"
+	CauseMandateActivated        Cause = "mandate_activated"
+	CauseMandateCancelled        Cause = "mandate_cancelled"
+	CauseMandateCreated          Cause = "mandate_created"
+	CauseMandateExpired          Cause = "mandate_expired"
+	CauseMandateReinstated       Cause = "mandate_reinstated"
+	CauseMandateReplaced         Cause = "mandate_replaced"
+	CauseMandateSubmitted        Cause = "mandate_submitted"
+	CauseMandateSuspendedByPayer Cause = "mandate_suspended_by_payer"
+	CauseMandateTransferred      Cause = "mandate_transferred"
+	CauseOther                   Cause = "other"
+	CausePaymentAutoretried      Cause = "payment_autoretried"
+	CausePaymentCancelled        Cause = "payment_cancelled"
+	CausePaymentConfirmed        Cause = "payment_confirmed"
+	CausePaymentCreated          Cause = "payment_created"
+	CausePaymentPaidOut          Cause = "payment_paid_out"
+	CausePaymentRetried          Cause = "payment_retried"
+	CausePaymentStopped          Cause = "payment_stopped"
+	CausePaymentSubmitted        Cause = "payment_submitted"
+	CausePayoutPaid              Cause = "payout_paid"
+	CausePlanCancelled           Cause = "plan_cancelled"
+	CauseReferToPayer            Cause = "refer_to_payer"
+	CauseRefundCreated           Cause = "refund_created"
+	CauseRefundFailed            Cause = "refund_failed"
+	CauseRefundFundsReturned     Cause = "refund_funds_returned"
+	CauseRefundPaid              Cause = "refund_paid"
+	CauseRefundSettled           Cause = "refund_settled"
+	CauseResubmissionRequested   Cause = "resubmission_requested"
+	CauseReturnOnODFIRequest     Cause = "return_on_odfi_request"
+	CauseSubscriptionCancelled   Cause = "subscription_cancelled"
+	CauseSubscriptionCreated     Cause = "subscription_created"
+	CauseSubscriptionFinished    Cause = "subscription_finished"
+	CauseTestFailure             Cause = "test_failure"
+)
+
+// IsFailure reports whether the event records a failure: a payment which failed or was charged back,
+// a mandate which failed to be set up, a refund which failed or was returned, an instalment schedule
+// which could not be created or a billing request which failed
+func (e *Event) IsFailure() bool {
+	switch e.ResourceType {
+	case ResourceTypePayments:
+		switch e.Action {
+		case ActionPaymentFailed, ActionPaymentChargedBack, ActionPaymentLateFailureSettled, ActionPaymentChargebackSettled:
+			return true
+		}
+	case ResourceTypeMandates:
+		return e.Action == ActionMandateFailed
+	case ResourceTypeRefunds:
+		return e.Action == ActionRefundFailed || e.Action == ActionRefundFundsReturned
+	case ResourceTypeInstalmentSchedules:
+		return e.Action == ActionInstalmentScheduleCreationFailed || e.Action == ActionInstalmentScheduleErrored
+	case ResourceTypeBillingRequests:
+		return e.Action == ActionBillingRequestFailed
+	}
+	return false
+}
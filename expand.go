@@ -0,0 +1,158 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// maxExpandConcurrency the number of resources fetched at the same time while expanding events
+const maxExpandConcurrency = 8
+
+type (
+	// ExpandedEvent an Event with the resources it links to, a resource is nil when the event does not link to it
+	ExpandedEvent struct {
+		*Event
+		Payment      *Payment
+		Mandate      *Mandate
+		Subscription *Subscription
+		Payout       *Payout
+		Refund       *Refund
+		Creditor     *Creditor
+	}
+
+	// expandKey identifies a resource to fetch while expanding events
+	expandKey struct {
+		resourceType ResourceType
+		id           string
+	}
+)
+
+// ExpandEvent fetches the payment, mandate, subscription, payout, refund and creditor linked to event concurrently.
+//
+// Relative endpoints: GET /payments/PM123, GET /mandates/MD123, GET /subscriptions/SB123,
+// GET /payouts/PO123, GET /refunds/RF123 and GET /creditors/CR123
+func (c *Client) ExpandEvent(ctx context.Context, event *Event) (*ExpandedEvent, error) {
+	if event == nil {
+		return nil, errors.New("gocardless: event is required")
+	}
+	expanded, err := c.ExpandEvents(ctx, &EventList{Events: []*Event{event}})
+	if err != nil {
+		return nil, err
+	}
+	return expanded[0], err
+}
+
+// ExpandEvents fetches the resources linked to the events of list concurrently, e.g. those of a webhook.
+// A resource linked to several events is fetched once and shared by their ExpandedEvent.
+// The first error cancels the remaining requests and is returned.
+// The expanded events are in the order of list.Events, nil events are skipped.
+//
+// Relative endpoints: GET /payments/PM123, GET /mandates/MD123, GET /subscriptions/SB123,
+// GET /payouts/PO123, GET /refunds/RF123 and GET /creditors/CR123
+func (c *Client) ExpandEvents(ctx context.Context, list *EventList) ([]*ExpandedEvent, error) {
+	if list == nil || len(list.Events) == 0 {
+		return []*ExpandedEvent{}, nil
+	}
+
+	fetches := make(map[expandKey]func(ctx context.Context) (interface{}, error))
+	add := func(resourceType ResourceType, id string, fetch func(ctx context.Context) (interface{}, error)) {
+		if id != "" {
+			fetches[expandKey{resourceType, id}] = fetch
+		}
+	}
+	for _, event := range list.Events {
+		if event == nil {
+			continue
+		}
+		links := event.Links
+		add(ResourceTypePayments, links.PaymentID, func(ctx context.Context) (interface{}, error) {
+			return c.GetPayment(ctx, links.PaymentID)
+		})
+		add(ResourceTypeMandates, links.MandateID, func(ctx context.Context) (interface{}, error) {
+			return c.GetMandate(ctx, links.MandateID)
+		})
+		add(ResourceTypeSubscriptions, links.SubscriptionID, func(ctx context.Context) (interface{}, error) {
+			return c.GetSubscription(ctx, links.SubscriptionID)
+		})
+		add(ResourceTypePayouts, links.PayoutID, func(ctx context.Context) (interface{}, error) {
+			return c.GetPayout(ctx, links.PayoutID)
+		})
+		add(ResourceTypeRefunds, links.RefundID, func(ctx context.Context) (interface{}, error) {
+			return c.GetRefund(ctx, links.RefundID)
+		})
+		add(ResourceTypeCreditors, links.CreditorID, func(ctx context.Context) (interface{}, error) {
+			return c.GetCreditor(ctx, links.CreditorID)
+		})
+	}
+
+	resources, err := fetchConcurrently(ctx, fetches)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := make([]*ExpandedEvent, 0, len(list.Events))
+	for _, event := range list.Events {
+		if event == nil {
+			continue
+		}
+		e := &ExpandedEvent{Event: event}
+		e.Payment, _ = resources[expandKey{ResourceTypePayments, event.Links.PaymentID}].(*Payment)
+		e.Mandate, _ = resources[expandKey{ResourceTypeMandates, event.Links.MandateID}].(*Mandate)
+		e.Subscription, _ = resources[expandKey{ResourceTypeSubscriptions, event.Links.SubscriptionID}].(*Subscription)
+		e.Payout, _ = resources[expandKey{ResourceTypePayouts, event.Links.PayoutID}].(*Payout)
+		e.Refund, _ = resources[expandKey{ResourceTypeRefunds, event.Links.RefundID}].(*Refund)
+		e.Creditor, _ = resources[expandKey{ResourceTypeCreditors, event.Links.CreditorID}].(*Creditor)
+		expanded = append(expanded, e)
+	}
+	return expanded, nil
+}
+
+// fetchConcurrently runs the fetches with at most maxExpandConcurrency at a time,
+// stopping at the first error
+func fetchConcurrently(ctx context.Context, fetches map[expandKey]func(ctx context.Context) (interface{}, error)) (map[expandKey]interface{}, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		firstErr  error
+		resources = make(map[expandKey]interface{}, len(fetches))
+		slots     = make(chan struct{}, maxExpandConcurrency)
+	)
+	for key, fetch := range fetches {
+		wg.Add(1)
+		go func(key expandKey, fetch func(ctx context.Context) (interface{}, error)) {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr == nil {
+					firstErr = ctx.Err()
+				}
+				return
+			}
+
+			resource, err := fetch(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			resources[key] = resource
+		}(key, fetch)
+	}
+	wg.Wait()
+
+	return resources, firstErr
+}
@@ -0,0 +1,91 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExpandEventsFetchesSharedResourcesOnce(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests = map[string]int{}
+	)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/payments/PM1":
+			w.Write([]byte(`{"payments":{"id":"PM1"}}`))
+		case "/mandates/MD1":
+			w.Write([]byte(`{"mandates":{"id":"MD1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	list := &EventList{Events: []*Event{
+		{ID: "EV1", Links: eventLinks{PaymentID: "PM1", MandateID: "MD1"}},
+		nil,
+		{ID: "EV2", Links: eventLinks{PaymentID: "PM1"}},
+	}}
+	expanded, err := c.ExpandEvents(context.Background(), list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(expanded) != 2 || expanded[0].ID != "EV1" || expanded[1].ID != "EV2" {
+		t.Fatalf("got %d expanded events, want EV1 and EV2", len(expanded))
+	}
+	if expanded[0].Payment == nil || expanded[0].Payment != expanded[1].Payment {
+		t.Errorf("got payments %p and %p, want the same *Payment", expanded[0].Payment, expanded[1].Payment)
+	}
+	if expanded[0].Mandate == nil || expanded[0].Mandate.ID != "MD1" || expanded[1].Mandate != nil {
+		t.Errorf("got mandates %v and %v, want MD1 on EV1 only", expanded[0].Mandate, expanded[1].Mandate)
+	}
+	if requests["/payments/PM1"] != 1 || requests["/mandates/MD1"] != 1 || len(requests) != 2 {
+		t.Errorf("got requests %v, want one GET per resource", requests)
+	}
+
+	if _, err := c.ExpandEvent(context.Background(), nil); err == nil {
+		t.Error("ExpandEvent(nil) returned no error")
+	}
+}
+
+func TestExpandEventsCancelsOnError(t *testing.T) {
+	canceled := make(chan bool, 1)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/payments/PM1":
+			// answers only once the request is canceled
+			select {
+			case <-r.Context().Done():
+				canceled <- true
+			case <-time.After(5 * time.Second):
+				canceled <- false
+				w.Write([]byte(`{"payments":{"id":"PM1"}}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"Resource not found","type":"invalid_api_usage","code":404}}`))
+		}
+	}))
+
+	list := &EventList{Events: []*Event{
+		{ID: "EV1", Links: eventLinks{PaymentID: "PM1", MandateID: "MD1"}},
+	}}
+	_, err := c.ExpandEvents(context.Background(), list)
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Errorf("ExpandEvents() = %v, want the 404 of the mandate", err)
+	}
+	if !<-canceled {
+		t.Error("the payment request was not canceled")
+	}
+}
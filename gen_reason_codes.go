@@ -0,0 +1,73 @@
+//go:build ignore
+
+// gen_reason_codes generates reason_codes_table.go from reason_codes.txt, run it with go generate.
+//
+// Each line of reason_codes.txt holds a reason code and its description separated by a tab,
+// lines starting with # are comments introducing the codes of a report and blank lines separate them
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	source = "reason_codes.txt"
+	output = "reason_codes_table.go"
+)
+
+func main() {
+	f, err := os.Open(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_reason_codes.go from %s; DO NOT EDIT.\n\n", source)
+	b.WriteString("package gocardless\n\n")
+	b.WriteString("// reasonCodes maps the scheme reason codes reported in the details of events to their description.\n")
+	b.WriteString("// Bacs codes are prefixed by the report they come from: ARUDD for failed payments, ADDACS for\n")
+	b.WriteString("// mandates amended or cancelled by the payer's bank and AUDDIS for mandates rejected at setup.\n")
+	b.WriteString("// SEPA codes are the ISO 20022 reason codes of rejects, returns and refunds\n")
+	b.WriteString("var reasonCodes = map[string]string{\n")
+
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			b.WriteString("\n")
+		case strings.HasPrefix(line, "#"):
+			fmt.Fprintf(&b, "// %s\n", strings.TrimSpace(strings.TrimPrefix(line, "#")))
+		default:
+			fields := strings.SplitN(line, "\t", 2)
+			if len(fields) != 2 || fields[0] == "" || strings.TrimSpace(fields[1]) == "" {
+				log.Fatalf("%s:%d: want a code and a description separated by a tab", source, n)
+			}
+			if seen[fields[0]] {
+				log.Fatalf("%s:%d: duplicate reason code %s", source, n, fields[0])
+			}
+			seen[fields[0]] = true
+			fmt.Fprintf(&b, "%q: %q,\n", fields[0], strings.TrimSpace(fields[1]))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
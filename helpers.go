@@ -12,11 +12,23 @@ type (
 	}
 )
 
+const dateLayout = "2006-01-02"
+
+// NewDate returns the Date of t, e.g. to set the charge date of a payment
+func NewDate(t time.Time) *Date {
+	return &Date{Time: t}
+}
+
+// MarshalJSON formats the date as expected by the API, e.g. "2014-10-20"
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.Time.Format(dateLayout) + `"`), nil
+}
+
 // UnmarshalJSON imeplement Marshaler und Unmarshalere interface
 func (d *Date) UnmarshalJSON(b []byte) error {
 	strInput := string(b)
 	strInput = strings.Trim(strInput, `"`)
-	newTime, err := time.Parse("2006-01-02", strInput)
+	newTime, err := time.Parse(dateLayout, strInput)
 
 	if err != nil {
 		return err
@@ -25,6 +37,18 @@ func (d *Date) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// nonEmpty returns the fields which have a value, so the unset ones are left out of a request body
+// instead of being sent as blank strings
+func nonEmpty(fields map[string]string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		if value != "" {
+			data[key] = value
+		}
+	}
+	return data
+}
+
 // Centify amount in floats by multiplying by 100, so 12.25 -> 1225.
 // Use when creating payments as amount should be in Pence or Cents
 func Centify(amount float64) int {
@@ -0,0 +1,80 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// recordBody returns a handler answering body and recording the body of the last request
+func recordBody(dst *[]byte, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*dst, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(body))
+	})
+}
+
+func TestDateJSON(t *testing.T) {
+	d := NewDate(time.Date(2024, 5, 1, 15, 4, 5, 0, time.UTC))
+
+	bs, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bs) != `"2024-05-01"` {
+		t.Errorf("Marshal() = %s, want \"2024-05-01\"", bs)
+	}
+
+	var parsed Date
+	if err := json.Unmarshal(bs, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Time.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unmarshal() = %s, want 2024-05-01", parsed.Time)
+	}
+}
+
+func TestCreateSendsDates(t *testing.T) {
+	var body []byte
+	date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	c := newTestClient(t, recordBody(&body, `{"payments":{"id":"PM123"}}`))
+	payment := NewPayment(1000, "GBP", "MD123")
+	payment.ChargeDate = NewDate(date)
+	if err := c.CreatePayment(context.Background(), payment); err != nil {
+		t.Fatal(err)
+	}
+	var paymentReq struct {
+		Payments struct {
+			ChargeDate string `json:"charge_date"`
+		} `json:"payments"`
+	}
+	if err := json.Unmarshal(body, &paymentReq); err != nil {
+		t.Fatal(err)
+	}
+	if paymentReq.Payments.ChargeDate != "2024-05-01" {
+		t.Errorf("charge_date = %q, want 2024-05-01 in %s", paymentReq.Payments.ChargeDate, body)
+	}
+
+	c = newTestClient(t, recordBody(&body, `{"subscriptions":{"id":"SB123"}}`))
+	subscription := NewSubscription(1000, "GBP", "monthly", "MD123")
+	subscription.StartDate = NewDate(date)
+	if err := c.CreateSubscription(context.Background(), subscription); err != nil {
+		t.Fatal(err)
+	}
+	var subscriptionReq struct {
+		Subscriptions struct {
+			StartDate string `json:"start_date"`
+		} `json:"subscriptions"`
+	}
+	if err := json.Unmarshal(body, &subscriptionReq); err != nil {
+		t.Fatal(err)
+	}
+	if subscriptionReq.Subscriptions.StartDate != "2024-05-01" {
+		t.Errorf("start_date = %q, want 2024-05-01 in %s", subscriptionReq.Subscriptions.StartDate, body)
+	}
+}
@@ -0,0 +1,26 @@
+package gocardless
+
+import (
+	"context"
+)
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx carrying the Idempotency-Key sent with POST requests made using it,
+// e.g. CreatePayment, CreateMandate or RetryPayment. Derive the key from your own identifiers, such as an order ID,
+// so that repeating a request after a crash does not create the resource twice.
+// Without a key in the context a random one is generated for every request
+//
+// https://developer.gocardless.com/api-reference/#making-requests-idempotency-keys
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key stored in ctx by WithIdempotencyKey, if any
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	if !ok || key == "" {
+		return "", false
+	}
+	return key, true
+}
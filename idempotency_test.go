@@ -0,0 +1,41 @@
+package gocardless
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithIdempotencyKey(t *testing.T) {
+	var keys []string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"payments":{"id":"PM123"}}`))
+	}))
+
+	ctx := WithIdempotencyKey(context.Background(), "order-42")
+	for i := 0; i < 2; i++ {
+		if err := c.CreatePayment(ctx, NewPayment(1000, "GBP", "MD123")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if err := c.CreatePayment(WithIdempotencyKey(context.Background(), ""), NewPayment(1000, "GBP", "MD123")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.GetPayment(ctx, "PM123"); err != nil {
+		t.Fatal(err)
+	}
+
+	if keys[0] != "order-42" || keys[1] != "order-42" {
+		t.Errorf("sent Idempotency-Key %q and %q, want order-42", keys[0], keys[1])
+	}
+	if keys[2] == "" || keys[3] == "" || keys[2] == keys[3] {
+		t.Errorf("sent Idempotency-Key %q and %q without a key in the context, want distinct random keys", keys[2], keys[3])
+	}
+	if keys[4] != "" {
+		t.Errorf("GET sent Idempotency-Key %q, want none", keys[4])
+	}
+}
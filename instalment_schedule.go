@@ -0,0 +1,266 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	instalmentScheduleEndpoint = "instalment_schedules"
+)
+
+type (
+	// InstalmentSchedule objects represent a fixed total amount collected from a customer in a series of payments,
+	// e.g. to pay for goods on a payment plan
+	InstalmentSchedule struct {
+		// ID is a unique identifier, beginning with "IS".
+		ID string `json:"id,omitempty"`
+		// CreatedAt is a fixed timestamp, recording when the instalment schedule was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// Name of the instalment schedule, up to 100 characters
+		Name string `json:"name"`
+		// Currency currency code, currently GBP, EUR, SEK, DKK, AUD, NZD, CAD and USD are supported
+		Currency string `json:"currency"`
+		// TotalAmount The total amount of the instalment schedule, in pence/cents/öre/øre.
+		// It must match the sum of the amounts of the instalments
+		TotalAmount int `json:"total_amount"`
+		// AppFee The amount to be deducted from each payment as the OAuth app’s fee, in pence/cents/öre/øre
+		AppFee int `json:"app_fee,omitempty"`
+		// PaymentReference An optional reference that will appear on your customer’s bank statement
+		PaymentReference string `json:"payment_reference,omitempty"`
+		// Retry On failure, automatically retry payments using intelligent retries
+		Retry bool `json:"retry_if_possible,omitempty"`
+		// Status status of instalment schedule.
+		Status string `json:"status,omitempty"`
+		// PaymentErrors the errors of the payments which could not be created, keyed by instalment index
+		PaymentErrors map[string][]*ErrorDetail `json:"payment_errors,omitempty"`
+		// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+		// characters and values up to 500 characters.
+		Metadata map[string]string `json:"metadata,omitempty"`
+		// Links to mandate, customer and the payments created by the schedule
+		Links instalmentScheduleLinks `json:"links"`
+	}
+	instalmentScheduleLinks struct {
+		MandateID  string   `json:"mandate,omitempty"`
+		CustomerID string   `json:"customer,omitempty"`
+		PaymentIDs []string `json:"payments,omitempty"`
+	}
+
+	// Instalment a payment of an instalment schedule created with explicit dates
+	Instalment struct {
+		// Amount in pence (GBP), cents (AUD/EUR), öre (SEK), or øre (DKK).
+		Amount int `json:"amount"`
+		// ChargeDate A future date on which the payment should be collected.
+		// If not specified, the payment will be collected as soon as possible
+		ChargeDate *Date `json:"charge_date,omitempty"`
+		// Description A human-readable description of the payment
+		Description string `json:"description,omitempty"`
+	}
+
+	// InstalmentPlan the payments of an instalment schedule collected at a regular interval
+	InstalmentPlan struct {
+		// Amounts of each payment in pence/cents/öre/øre, in order of collection
+		Amounts []int `json:"amounts"`
+		// Interval Number of interval units between charge dates
+		Interval int `json:"interval"`
+		// IntervalUnit The unit of time between charge dates. One of weekly, monthly or yearly.
+		IntervalUnit string `json:"interval_unit"`
+		// StartDate The date of the first payment, defaults to the earliest possible date
+		StartDate *Date `json:"start_date,omitempty"`
+	}
+
+	// instalmentScheduleWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	instalmentScheduleWrapper struct {
+		InstalmentSchedule *InstalmentSchedule `json:"instalment_schedules"`
+	}
+	// instalmentScheduleRequest adds the instalments, a list of Instalment or an InstalmentPlan, to the created schedule
+	instalmentScheduleRequest struct {
+		*InstalmentSchedule
+		Instalments interface{} `json:"instalments"`
+	}
+
+	// InstalmentScheduleListResponse a List response of InstalmentSchedule instances
+	InstalmentScheduleListResponse struct {
+		InstalmentSchedules []*InstalmentSchedule `json:"instalment_schedules"`
+		Meta                Meta                  `json:"meta,omitempty"`
+	}
+
+	// InstalmentScheduleListParams parameters to filter and paginate the list of instalment schedules
+	InstalmentScheduleListParams struct {
+		ListParams
+		// CreatedAt filters instalment schedules by creation time
+		CreatedAt TimeRange
+		// Customer only returns instalment schedules of this customer ID
+		Customer string
+		// Mandate only returns instalment schedules of this mandate ID
+		Mandate string
+		// Status only returns instalment schedules in these statuses, e.g. active
+		Status []string
+	}
+
+	// InstalmentScheduleIterator iterates over a list of instalment schedules, fetching further pages as needed
+	InstalmentScheduleIterator struct {
+		pager
+		page []*InstalmentSchedule
+	}
+)
+
+func (s *InstalmentSchedule) String() string {
+	bs, _ := json.Marshal(s)
+	return string(bs)
+}
+
+// NewInstalmentSchedule instantiate new instalment schedule object
+func NewInstalmentSchedule(name string, totalAmount int, currency, mandateID string) *InstalmentSchedule {
+	return &InstalmentSchedule{
+		Name:        name,
+		TotalAmount: totalAmount,
+		Currency:    currency,
+		Links:       instalmentScheduleLinks{MandateID: mandateID},
+	}
+}
+
+// AddMetadata adds new metadata item to instalment schedule object
+func (s *InstalmentSchedule) AddMetadata(key, value string) {
+	if s.Metadata == nil {
+		s.Metadata = make(map[string]string)
+	}
+	s.Metadata[key] = value
+}
+
+// CreateInstalmentScheduleWithDates creates a new instalment schedule collecting each instalment on its charge date.
+// The payments are created asynchronously, once the schedule is active their IDs are listed in Links.PaymentIDs
+//
+// Relative endpoint: POST /instalment_schedules
+func (c *Client) CreateInstalmentScheduleWithDates(ctx context.Context, schedule *InstalmentSchedule, instalments []*Instalment) error {
+	return c.createInstalmentSchedule(ctx, schedule, instalments)
+}
+
+// CreateInstalmentScheduleWithSchedule creates a new instalment schedule collecting the amounts of plan at a regular interval.
+// The payments are created asynchronously, once the schedule is active their IDs are listed in Links.PaymentIDs
+//
+// Relative endpoint: POST /instalment_schedules
+func (c *Client) CreateInstalmentScheduleWithSchedule(ctx context.Context, schedule *InstalmentSchedule, plan *InstalmentPlan) error {
+	return c.createInstalmentSchedule(ctx, schedule, plan)
+}
+
+func (c *Client) createInstalmentSchedule(ctx context.Context, schedule *InstalmentSchedule, instalments interface{}) error {
+	scheduleData := map[string]interface{}{
+		"instalment_schedules": &instalmentScheduleRequest{schedule, instalments},
+	}
+
+	scheduleReq := &instalmentScheduleWrapper{schedule}
+
+	err := c.create(ctx, instalmentScheduleEndpoint, scheduleData, scheduleReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// GetInstalmentSchedules returns a cursor-paginated list of your instalment schedules.
+//
+// Relative endpoint: GET /instalment_schedules
+func (c *Client) GetInstalmentSchedules(ctx context.Context) (*InstalmentScheduleListResponse, error) {
+	return c.GetInstalmentSchedulesWithParams(ctx, nil)
+}
+
+// GetInstalmentSchedulesWithParams is GetInstalmentSchedules with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /instalment_schedules
+func (c *Client) GetInstalmentSchedulesWithParams(ctx context.Context, params *InstalmentScheduleListParams) (*InstalmentScheduleListResponse, error) {
+	list := &InstalmentScheduleListResponse{}
+
+	err := c.get(ctx, listPath(instalmentScheduleEndpoint, params.values()), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *InstalmentScheduleListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "customer", params.Customer)
+	setString(query, "mandate", params.Mandate)
+	setList(query, "status", params.Status)
+	return query
+}
+
+// InstalmentSchedules returns an iterator over all your instalment schedules matching params,
+// following the pagination cursors. params may be nil.
+//
+// Relative endpoint: GET /instalment_schedules
+func (c *Client) InstalmentSchedules(ctx context.Context, params *InstalmentScheduleListParams) *InstalmentScheduleIterator {
+	it := &InstalmentScheduleIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &InstalmentScheduleListResponse{}
+		if err := c.get(ctx, listPath(instalmentScheduleEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.InstalmentSchedules
+		return len(list.InstalmentSchedules), list.Meta, nil
+	})
+	return it
+}
+
+// InstalmentSchedule returns the current instalment schedule, or nil unless the last call to Next returned true
+func (it *InstalmentScheduleIterator) InstalmentSchedule() *InstalmentSchedule {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// GetInstalmentSchedule retrieves the details of an existing instalment schedule.
+//
+// Relative endpoint: GET /instalment_schedules/IS123
+func (c *Client) GetInstalmentSchedule(ctx context.Context, id string) (*InstalmentSchedule, error) {
+	wrapper := &instalmentScheduleWrapper{}
+
+	err := c.get(ctx, fmt.Sprintf(`%s/%s`, instalmentScheduleEndpoint, id), wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.InstalmentSchedule, err
+}
+
+// UpdateInstalmentSchedule Updates an instalment schedule object. Only the metadata parameter is allowed.
+//
+// Relative endpoint: PUT /instalment_schedules/IS123
+func (c *Client) UpdateInstalmentSchedule(ctx context.Context, schedule *InstalmentSchedule) error {
+	// allows only metadata
+	scheduleMeta := map[string]interface{}{
+		"instalment_schedules": map[string]interface{}{
+			"metadata": schedule.Metadata,
+		},
+	}
+
+	scheduleReq := &instalmentScheduleWrapper{schedule}
+
+	err := c.put(ctx, fmt.Sprintf(`%s/%s`, instalmentScheduleEndpoint, schedule.ID), scheduleMeta, scheduleReq)
+	if err != nil {
+		return err
+	}
+	return err
+}
+
+// CancelInstalmentSchedule immediately cancels an instalment schedule and all of its cancellable payments.
+//
+// Relative endpoint: POST /instalment_schedules/IS123/actions/cancel
+func (c *Client) CancelInstalmentSchedule(ctx context.Context, id string) (*InstalmentSchedule, error) {
+	wrapper := &instalmentScheduleWrapper{}
+	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/cancel`, instalmentScheduleEndpoint, id), nil, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.InstalmentSchedule, err
+}
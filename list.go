@@ -0,0 +1,177 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListParams cursor pagination parameters accepted by every list endpoint
+type ListParams struct {
+	// Limit Upper bound for the number of objects to be returned per page. Defaults to 50. Maximum of 500
+	Limit int
+	// After ID of the object immediately preceding the first object to be returned
+	After string
+	// Before ID of the object immediately following the last object to be returned
+	Before string
+}
+
+func (p ListParams) values() url.Values {
+	query := url.Values{}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.After != "" {
+		query.Set("after", p.After)
+	}
+	if p.Before != "" {
+		query.Set("before", p.Before)
+	}
+	return query
+}
+
+// TimeRange filters objects by a timestamp such as created_at, zero bounds are ignored
+type TimeRange struct {
+	// GT only returns objects strictly after this time
+	GT time.Time
+	// GTE only returns objects at or after this time
+	GTE time.Time
+	// LT only returns objects strictly before this time
+	LT time.Time
+	// LTE only returns objects at or before this time
+	LTE time.Time
+}
+
+func (r TimeRange) setValues(query url.Values, name string) {
+	setRange(query, name, time.RFC3339, r.GT, r.GTE, r.LT, r.LTE)
+}
+
+// DateRange filters objects by a date such as charge_date, zero bounds are ignored
+type DateRange struct {
+	// GT only returns objects strictly after this date
+	GT time.Time
+	// GTE only returns objects on or after this date
+	GTE time.Time
+	// LT only returns objects strictly before this date
+	LT time.Time
+	// LTE only returns objects on or before this date
+	LTE time.Time
+}
+
+func (r DateRange) setValues(query url.Values, name string) {
+	setRange(query, name, dateLayout, r.GT, r.GTE, r.LT, r.LTE)
+}
+
+// setRange encodes the bounds of a range filter using the bracketed format of the API, e.g. created_at[gte]
+func setRange(query url.Values, name, layout string, gt, gte, lt, lte time.Time) {
+	bounds := []struct {
+		operator string
+		value    time.Time
+	}{{"gt", gt}, {"gte", gte}, {"lt", lt}, {"lte", lte}}
+
+	for _, bound := range bounds {
+		if !bound.value.IsZero() {
+			query.Set(fmt.Sprintf("%s[%s]", name, bound.operator), bound.value.Format(layout))
+		}
+	}
+}
+
+// setString sets a query parameter unless value is empty
+func setString(query url.Values, name, value string) {
+	if value != "" {
+		query.Set(name, value)
+	}
+}
+
+// setList sets a query parameter accepting a comma separated list of values, e.g. status=active,pending
+func setList(query url.Values, name string, values []string) {
+	if len(values) > 0 {
+		query.Set(name, strings.Join(values, ","))
+	}
+}
+
+// setBool sets a query parameter unless value is nil
+func setBool(query url.Values, name string, value *bool) {
+	if value != nil {
+		query.Set(name, strconv.FormatBool(*value))
+	}
+}
+
+// listPath appends the encoded query string to the path of a list endpoint
+func listPath(endpoint string, query url.Values) string {
+	if len(query) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + query.Encode()
+}
+
+// pageFetcher fetches the page of a list endpoint selected by query,
+// returning the number of objects on the page and its metadata
+type pageFetcher func(ctx context.Context, query url.Values) (int, Meta, error)
+
+// pager walks the pages of a list endpoint by following the after cursor,
+// the iterator of each resource embeds it and exposes the objects of the current page
+type pager struct {
+	ctx     context.Context
+	query   url.Values
+	fetch   pageFetcher
+	meta    Meta
+	err     error
+	started bool
+	size    int
+	index   int
+	// current is set while the iterator is positioned on an object, i.e. the last call to Next returned true
+	current bool
+}
+
+func newPager(ctx context.Context, query url.Values, fetch pageFetcher) pager {
+	return pager{ctx: ctx, query: query, fetch: fetch}
+}
+
+// Next advances the iterator to the next object, fetching the next page once the current one is exhausted.
+// It returns false when there are no objects left, the context is done or a request failed, check Err to tell them apart
+func (p *pager) Next() bool {
+	p.current = false
+	for p.index+1 >= p.size {
+		if p.err != nil {
+			return false
+		}
+		if p.started {
+			if p.meta.Cursors.After == "" {
+				return false
+			}
+			p.query.Del("before")
+			p.query.Set("after", p.meta.Cursors.After)
+		}
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		size, meta, err := p.fetch(p.ctx, p.query)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.started = true
+		p.size = size
+		p.index = -1
+		p.meta = meta
+	}
+	p.index++
+	p.current = true
+	return true
+}
+
+// Err returns the error which stopped the iteration, if any
+func (p *pager) Err() error {
+	return p.err
+}
+
+// Meta returns the pagination metadata of the current page
+func (p *pager) Meta() Meta {
+	return p.meta
+}
@@ -0,0 +1,184 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pagedCustomers serves the customers list as pages keyed by the after cursor, counting the requests
+type pagedCustomers struct {
+	mu       sync.Mutex
+	pages    map[string]string
+	requests int
+}
+
+func (p *pagedCustomers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests++
+
+	page, ok := p.pages[r.URL.Query().Get("after")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Write([]byte(page))
+}
+
+func newPagedCustomers() *pagedCustomers {
+	return &pagedCustomers{pages: map[string]string{
+		"":   `{"customers":[{"id":"CU1"},{"id":"CU2"}],"meta":{"cursors":{"after":"c1"},"limit":2}}`,
+		"c1": `{"customers":[],"meta":{"cursors":{"after":"c2"},"limit":2}}`,
+		"c2": `{"customers":[{"id":"CU3"}],"meta":{"cursors":{"after":""},"limit":2}}`,
+	}}
+}
+
+func TestIteratorFollowsCursors(t *testing.T) {
+	pages := newPagedCustomers()
+	c := newTestClient(t, pages)
+
+	it := c.Customers(context.Background(), nil)
+	if customer := it.Customer(); customer != nil {
+		t.Errorf("Customer() before Next = %v, want nil", customer)
+	}
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Customer().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := strings.Join(ids, ","); got != "CU1,CU2,CU3" {
+		t.Errorf("got customers %s, want CU1,CU2,CU3", got)
+	}
+	if pages.requests != 3 {
+		t.Errorf("got %d requests, want 3", pages.requests)
+	}
+	if customer := it.Customer(); customer != nil {
+		t.Errorf("Customer() after the last Next = %v, want nil", customer)
+	}
+	if it.Next() {
+		t.Error("Next() after the end = true, want false")
+	}
+}
+
+func TestIteratorCanceledContext(t *testing.T) {
+	pages := newPagedCustomers()
+	c := newTestClient(t, pages)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := c.Customers(ctx, nil)
+	if it.Next() {
+		t.Fatal("Next() with a canceled context = true, want false")
+	}
+	if err := it.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Err() = %v, want context.Canceled", err)
+	}
+	if pages.requests != 0 {
+		t.Errorf("got %d requests, want 0", pages.requests)
+	}
+}
+
+func TestIteratorCanceledBetweenPages(t *testing.T) {
+	pages := newPagedCustomers()
+	c := newTestClient(t, pages)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	it := c.Customers(ctx, nil)
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Customer().ID)
+		if len(ids) == 2 {
+			cancel()
+		}
+	}
+	if err := it.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Err() = %v, want context.Canceled", err)
+	}
+	if len(ids) != 2 {
+		t.Errorf("got customers %v, want the first page only", ids)
+	}
+	if pages.requests != 1 {
+		t.Errorf("got %d requests, want 1", pages.requests)
+	}
+}
+
+func TestEventIteratorLinked(t *testing.T) {
+	c := newTestClient(t, &pagedCustomers{pages: map[string]string{
+		"": `{"events":[{"id":"EV1","links":{"payment":"PM1"}}],"linked":{"payments":[{"id":"PM1"}]},` +
+			`"meta":{"cursors":{"after":"c1"},"limit":1}}`,
+		"c1": `{"events":[{"id":"EV2","links":{"payment":"PM2"}}],"linked":{"payments":[{"id":"PM2"}]},` +
+			`"meta":{"cursors":{"after":""},"limit":1}}`,
+	}})
+
+	it := c.Events(context.Background(), &EventListParams{ResourceType: ResourceTypePayments, Include: "payment"})
+	var linked []string
+	for it.Next() {
+		for _, payment := range it.Linked().Payments {
+			linked = append(linked, it.Event().ID+" "+payment.ID)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(linked, ","); got != "EV1 PM1,EV2 PM2" {
+		t.Errorf("got linked payments %s, want EV1 PM1,EV2 PM2", got)
+	}
+}
+
+// recordQuery returns a handler answering body and recording the raw query string of the last request
+func recordQuery(query *string, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*query = r.URL.RawQuery
+		w.Write([]byte(body))
+	})
+}
+
+func TestListParamsQuery(t *testing.T) {
+	var query string
+
+	c := newTestClient(t, recordQuery(&query, `{"payments":[]}`))
+	_, err := c.GetPaymentsWithParams(context.Background(), &PaymentListParams{
+		ListParams: ListParams{Limit: 10, After: "PM123"},
+		ChargeDate: DateRange{LTE: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		CreatedAt:  TimeRange{GTE: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Customer:   "CU123",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "after=PM123&charge_date%5Blte%5D=2024-03-31&created_at%5Bgte%5D=2024-01-01T00%3A00%3A00Z&customer=CU123&limit=10"
+	if query != want {
+		t.Errorf("query = %s, want %s", query, want)
+	}
+
+	c = newTestClient(t, recordQuery(&query, `{"mandates":[]}`))
+	_, err = c.GetMandatesWithParams(context.Background(), &MandateListParams{
+		Status: []string{"active", "pending_submission"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "status=active%2Cpending_submission"; query != want {
+		t.Errorf("query = %s, want %s", query, want)
+	}
+
+	c = newTestClient(t, recordQuery(&query, `{"customers":[]}`))
+	if _, err := c.GetCustomers(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if query != "" {
+		t.Errorf("query = %s, want none", query)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -53,6 +54,31 @@ type (
 		Mandates []*Mandate `json:"mandates"`
 		Meta     Meta       `json:"meta,omitempty"`
 	}
+
+	// MandateListParams parameters to filter and paginate the list of mandates
+	MandateListParams struct {
+		ListParams
+		// CreatedAt filters mandates by creation time
+		CreatedAt TimeRange
+		// Creditor only returns mandates of this creditor ID
+		Creditor string
+		// Customer only returns mandates of this customer ID
+		Customer string
+		// CustomerBankAccount only returns mandates of this customer bank account ID
+		CustomerBankAccount string
+		// Reference only returns mandates with this reference
+		Reference string
+		// Scheme only returns mandates of these schemes, e.g. bacs or sepa_core
+		Scheme []string
+		// Status only returns mandates in these statuses, e.g. active
+		Status []string
+	}
+
+	// MandateIterator iterates over a list of mandates, fetching further pages as needed
+	MandateIterator struct {
+		pager
+		page []*Mandate
+	}
 )
 
 func (m *Mandate) String() string {
@@ -78,7 +104,7 @@ func (m *Mandate) AddMetadata(key, value string) {
 func (c *Client) CreateMandate(ctx context.Context, mandate *Mandate) error {
 	mandateReq := &mandateWrapper{mandate}
 
-	err := c.post(ctx, mandateEndpoint, mandateReq, mandateReq)
+	err := c.create(ctx, mandateEndpoint, mandateReq, mandateReq)
 	if err != nil {
 		return err
 	}
@@ -90,15 +116,63 @@ func (c *Client) CreateMandate(ctx context.Context, mandate *Mandate) error {
 //
 // Relative endpoint: GET /mandates
 func (c *Client) GetMandates(ctx context.Context) (*MandateListResponse, error) {
+	return c.GetMandatesWithParams(ctx, nil)
+}
+
+// GetMandatesWithParams is GetMandates with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /mandates
+func (c *Client) GetMandatesWithParams(ctx context.Context, params *MandateListParams) (*MandateListResponse, error) {
 	list := &MandateListResponse{}
 
-	err := c.get(ctx, mandateEndpoint, list)
+	err := c.get(ctx, listPath(mandateEndpoint, params.values()), list)
 	if err != nil {
 		return nil, err
 	}
 	return list, err
 }
 
+func (params *MandateListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "creditor", params.Creditor)
+	setString(query, "customer", params.Customer)
+	setString(query, "customer_bank_account", params.CustomerBankAccount)
+	setString(query, "reference", params.Reference)
+	setList(query, "scheme", params.Scheme)
+	setList(query, "status", params.Status)
+	return query
+}
+
+// Mandates returns an iterator over all your mandates matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /mandates
+func (c *Client) Mandates(ctx context.Context, params *MandateListParams) *MandateIterator {
+	it := &MandateIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &MandateListResponse{}
+		if err := c.get(ctx, listPath(mandateEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Mandates
+		return len(list.Mandates), list.Meta, nil
+	})
+	return it
+}
+
+// Mandate returns the current mandate, or nil unless the last call to Next returned true
+func (it *MandateIterator) Mandate() *Mandate {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
 // GetMandate retrieves the details of an existing mandate.
 //
 // Relative endpoint: GET /mandates/MD123
@@ -0,0 +1,99 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	mandateImportEndpoint = "mandate_imports"
+)
+
+type (
+	// MandateImport Mandate Imports move existing mandates from another provider to GoCardless in bulk.
+	// Create an import, add an entry for each mandate, then submit it to be reviewed and processed.
+	MandateImport struct {
+		// ID is a unique identifier, beginning with "IM".
+		ID string `json:"id,omitempty"`
+		// CreatedAt is a fixed timestamp, recording when the mandate import was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// Scheme Direct Debit scheme of the imported mandates, e.g. bacs or sepa_core
+		Scheme string `json:"scheme"`
+		// Status status of mandate import, one of created, submitted, cancelled, processing or processed.
+		Status string `json:"status,omitempty"`
+		// Links to the creditor the mandates are imported for
+		Links mandateImportLinks `json:"links"`
+	}
+	mandateImportLinks struct {
+		CreditorID string `json:"creditor,omitempty"`
+	}
+	// mandateImportWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	mandateImportWrapper struct {
+		MandateImport *MandateImport `json:"mandate_imports"`
+	}
+)
+
+func (mi *MandateImport) String() string {
+	bs, _ := json.Marshal(mi)
+	return string(bs)
+}
+
+// NewMandateImport instantiate new mandate import object for the mandates of scheme
+func NewMandateImport(scheme string) *MandateImport {
+	return &MandateImport{
+		Scheme: scheme,
+	}
+}
+
+// CreateMandateImport creates a new mandate import object.
+//
+// Relative endpoint: POST /mandate_imports
+func (c *Client) CreateMandateImport(ctx context.Context, mandateImport *MandateImport) error {
+	mandateImportReq := &mandateImportWrapper{mandateImport}
+
+	err := c.create(ctx, mandateImportEndpoint, mandateImportReq, mandateImportReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// GetMandateImport retrieves the details of an existing mandate import.
+//
+// Relative endpoint: GET /mandate_imports/IM123
+func (c *Client) GetMandateImport(ctx context.Context, id string) (*MandateImport, error) {
+	wrapper := &mandateImportWrapper{}
+
+	err := c.get(ctx, fmt.Sprintf(`%s/%s`, mandateImportEndpoint, id), wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.MandateImport, err
+}
+
+// SubmitMandateImport submits a mandate import for review, no more entries can be added once it is submitted.
+//
+// Relative endpoint: POST /mandate_imports/IM123/actions/submit
+func (c *Client) SubmitMandateImport(ctx context.Context, id string) (*MandateImport, error) {
+	wrapper := &mandateImportWrapper{}
+	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/submit`, mandateImportEndpoint, id), nil, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.MandateImport, err
+}
+
+// CancelMandateImport cancels a mandate import which has not been processed yet, none of its mandates are imported.
+//
+// Relative endpoint: POST /mandate_imports/IM123/actions/cancel
+func (c *Client) CancelMandateImport(ctx context.Context, id string) (*MandateImport, error) {
+	wrapper := &mandateImportWrapper{}
+	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/cancel`, mandateImportEndpoint, id), nil, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.MandateImport, err
+}
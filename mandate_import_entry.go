@@ -0,0 +1,194 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+)
+
+const (
+	mandateImportEntryEndpoint = "mandate_import_entries"
+)
+
+type (
+	// MandateImportEntry an existing mandate added to a mandate import, with the details
+	// of the customer and bank account it is collected from
+	MandateImportEntry struct {
+		// CreatedAt is a fixed timestamp, recording when the entry was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// RecordIdentifier your own unique reference for the entry, used to match it to the created resources
+		RecordIdentifier string `json:"record_identifier,omitempty"`
+		// Customer the customer who signed the mandate
+		Customer *Customer `json:"customer,omitempty"`
+		// BankAccount the bank account the mandate is collected from, only the bank details are used
+		BankAccount *CustomerBankAccount `json:"bank_account,omitempty"`
+		// Amendment the details of the mandate with the previous provider, for Bacs imports
+		Amendment *MandateImportAmendment `json:"amendment,omitempty"`
+		// Links to the mandate import and, once processed, the created customer, bank account and mandate
+		Links mandateImportEntryLinks `json:"links"`
+	}
+	mandateImportEntryLinks struct {
+		MandateImportID       string `json:"mandate_import"`
+		CustomerID            string `json:"customer,omitempty"`
+		CustomerBankAccountID string `json:"customer_bank_account,omitempty"`
+		MandateID             string `json:"mandate,omitempty"`
+	}
+	// MandateImportAmendment the details of a mandate with the previous provider
+	MandateImportAmendment struct {
+		// OriginalMandateReference the reference of the mandate with the previous provider
+		OriginalMandateReference string `json:"original_mandate_reference"`
+		// OriginalCreditorID the service user number or creditor ID of the previous provider
+		OriginalCreditorID string `json:"original_creditor_id"`
+		// OriginalCreditorName the name of the creditor with the previous provider
+		OriginalCreditorName string `json:"original_creditor_name"`
+	}
+	// mandateImportEntryWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	mandateImportEntryWrapper struct {
+		MandateImportEntry *MandateImportEntry `json:"mandate_import_entries"`
+	}
+
+	// MandateImportEntryListResponse a List response of MandateImportEntry instances
+	MandateImportEntryListResponse struct {
+		MandateImportEntries []*MandateImportEntry `json:"mandate_import_entries"`
+		Meta                 Meta                  `json:"meta,omitempty"`
+	}
+
+	// MandateImportEntryListParams parameters to filter and paginate the list of mandate import entries
+	MandateImportEntryListParams struct {
+		ListParams
+		// Status only returns entries in this status, successfully_processed or unsuccessfully_processed
+		Status string
+	}
+
+	// MandateImportEntryIterator iterates over a list of mandate import entries, fetching further pages as needed
+	MandateImportEntryIterator struct {
+		pager
+		page []*MandateImportEntry
+	}
+)
+
+func (e *MandateImportEntry) String() string {
+	bs, _ := json.Marshal(e)
+	return string(bs)
+}
+
+// NewMandateImportEntry instantiate new mandate import entry object
+func NewMandateImportEntry(mandateImportID, recordIdentifier string, customer *Customer, bankAccount *CustomerBankAccount) *MandateImportEntry {
+	return &MandateImportEntry{
+		RecordIdentifier: recordIdentifier,
+		Customer:         customer,
+		BankAccount:      bankAccount,
+		Links:            mandateImportEntryLinks{MandateImportID: mandateImportID},
+	}
+}
+
+// CreateMandateImportEntry adds an entry to a mandate import which has not been submitted yet.
+//
+// Relative endpoint: POST /mandate_import_entries
+func (c *Client) CreateMandateImportEntry(ctx context.Context, entry *MandateImportEntry) error {
+	if entry.Customer == nil {
+		return errors.New("gocardless: customer is required")
+	}
+
+	// allows only the customer details, leaving out the unset ones
+	customerData := nonEmpty(map[string]string{
+		"email":                   entry.Customer.Email,
+		"given_name":              entry.Customer.GivenName,
+		"family_name":             entry.Customer.FamilyName,
+		"company_name":            entry.Customer.CompanyName,
+		"address_line1":           entry.Customer.AddressLine1,
+		"address_line2":           entry.Customer.AddressLine2,
+		"address_line3":           entry.Customer.AddressLine3,
+		"city":                    entry.Customer.City,
+		"region":                  entry.Customer.Region,
+		"postal_code":             entry.Customer.PostalCode,
+		"country_code":            entry.Customer.CountryCode,
+		"language":                entry.Customer.Language,
+		"swedish_identity_number": entry.Customer.SwedishIdentityNumber,
+	})
+	if entry.Customer.Metadata != nil {
+		customerData["metadata"] = entry.Customer.Metadata
+	}
+
+	entryData := map[string]interface{}{
+		"record_identifier": entry.RecordIdentifier,
+		"customer":          customerData,
+		"links": map[string]interface{}{
+			"mandate_import": entry.Links.MandateImportID,
+		},
+	}
+	if entry.BankAccount != nil {
+		// allows only the bank details
+		entryData["bank_account"] = nonEmpty(map[string]string{
+			"account_holder_name": entry.BankAccount.AccountHolderName,
+			"account_number":      entry.BankAccount.AccountNumber,
+			"bank_code":           entry.BankAccount.BankCode,
+			"branch_code":         entry.BankAccount.BranchCode,
+			"country_code":        entry.BankAccount.CountryCode,
+			"iban":                entry.BankAccount.IBAN,
+		})
+	}
+	if entry.Amendment != nil {
+		entryData["amendment"] = entry.Amendment
+	}
+
+	entryReq := &mandateImportEntryWrapper{entry}
+
+	err := c.post(ctx, mandateImportEntryEndpoint, map[string]interface{}{"mandate_import_entries": entryData}, entryReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// GetMandateImportEntries returns a cursor-paginated list of the entries of a mandate import,
+// including links to the resources created once it is processed. params may be nil.
+//
+// Relative endpoint: GET /mandate_import_entries?mandate_import=IM123
+func (c *Client) GetMandateImportEntries(ctx context.Context, mandateImportID string, params *MandateImportEntryListParams) (*MandateImportEntryListResponse, error) {
+	list := &MandateImportEntryListResponse{}
+
+	err := c.get(ctx, listPath(mandateImportEntryEndpoint, params.values(mandateImportID)), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *MandateImportEntryListParams) values(mandateImportID string) url.Values {
+	query := url.Values{}
+	if params != nil {
+		query = params.ListParams.values()
+		setString(query, "status", params.Status)
+	}
+	query.Set("mandate_import", mandateImportID)
+	return query
+}
+
+// MandateImportEntries returns an iterator over all the entries of a mandate import, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /mandate_import_entries?mandate_import=IM123
+func (c *Client) MandateImportEntries(ctx context.Context, mandateImportID string, params *MandateImportEntryListParams) *MandateImportEntryIterator {
+	it := &MandateImportEntryIterator{}
+	it.pager = newPager(ctx, params.values(mandateImportID), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &MandateImportEntryListResponse{}
+		if err := c.get(ctx, listPath(mandateImportEntryEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.MandateImportEntries
+		return len(list.MandateImportEntries), list.Meta, nil
+	})
+	return it
+}
+
+// MandateImportEntry returns the current mandate import entry, or nil unless the last call to Next returned true
+func (it *MandateImportEntryIterator) MandateImportEntry() *MandateImportEntry {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
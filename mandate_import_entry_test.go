@@ -0,0 +1,41 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestCreateMandateImportEntryOmitsBlankDetails(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"mandate_import_entries":{"record_identifier":"bank-file.xml/line-1"}}`))
+
+	customer := &Customer{GivenName: "Frank", FamilyName: "Osborne", CountryCode: "DE", Metadata: map[string]string{"ref": "123"}}
+	bankAccount := &CustomerBankAccount{AccountHolderName: "Frank Osborne", IBAN: "DE89370400440532013000"}
+	entry := NewMandateImportEntry("IM123", "bank-file.xml/line-1", customer, bankAccount)
+	if err := c.CreateMandateImportEntry(context.Background(), entry); err != nil {
+		t.Fatal(err)
+	}
+
+	var req struct {
+		MandateImportEntries struct {
+			Customer    map[string]interface{} `json:"customer"`
+			BankAccount map[string]interface{} `json:"bank_account"`
+		} `json:"mandate_import_entries"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	bankDetails := req.MandateImportEntries.BankAccount
+	if len(bankDetails) != 2 || bankDetails["iban"] != "DE89370400440532013000" {
+		t.Errorf("sent bank_account %v, want only account_holder_name and iban", bankDetails)
+	}
+	sentCustomer := req.MandateImportEntries.Customer
+	if len(sentCustomer) != 4 || sentCustomer["country_code"] != "DE" || sentCustomer["metadata"] == nil {
+		t.Errorf("sent customer %v, want only given_name, family_name, country_code and metadata", sentCustomer)
+	}
+
+	if err := c.CreateMandateImportEntry(context.Background(), NewMandateImportEntry("IM123", "line-2", nil, bankAccount)); err == nil {
+		t.Error("CreateMandateImportEntry() without a customer returned no error")
+	}
+}
@@ -0,0 +1,94 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	mandatePDFEndpoint = "mandate_pdfs"
+)
+
+type (
+	// MandatePDF a link to a PDF of a mandate, e.g. to send to customers signing up on paper
+	MandatePDF struct {
+		// URL the address to download the PDF from
+		URL string `json:"url"`
+		// ExpiresAt the time after which the URL can no longer be used
+		ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	}
+	// mandatePDFWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	mandatePDFWrapper struct {
+		MandatePDF *MandatePDF `json:"mandate_pdfs"`
+	}
+)
+
+func (pdf *MandatePDF) String() string {
+	bs, _ := json.Marshal(pdf)
+	return string(bs)
+}
+
+// CreateMandatePDF generates a PDF of an existing mandate.
+// language is an ISO 639-1 code such as "en" or "fr", an empty language uses the language of the customer
+//
+// Relative endpoint: POST /mandate_pdfs
+func (c *Client) CreateMandatePDF(ctx context.Context, mandateID, language string) (*MandatePDF, error) {
+	pdfData := map[string]interface{}{
+		"links": map[string]interface{}{
+			"mandate": mandateID,
+		},
+	}
+	return c.createMandatePDF(ctx, pdfData, language)
+}
+
+// CreateMandatePDFWithDetails generates a PDF of a mandate form prefilled with the details of customer and cba,
+// for the mandate to be signed before it is created. scheme may be empty when it can be inferred from the bank details.
+// language is an ISO 639-1 code such as "en" or "fr", an empty language defaults to the language of the scheme
+//
+// Relative endpoint: POST /mandate_pdfs
+func (c *Client) CreateMandatePDFWithDetails(ctx context.Context, customer *Customer, cba *CustomerBankAccount, scheme, language string) (*MandatePDF, error) {
+	if customer == nil || cba == nil {
+		return nil, errors.New("gocardless: customer and customer bank account are required")
+	}
+
+	countryCode := cba.CountryCode
+	if countryCode == "" {
+		countryCode = customer.CountryCode
+	}
+
+	pdfData := nonEmpty(map[string]string{
+		"account_holder_name":     cba.AccountHolderName,
+		"account_number":          cba.AccountNumber,
+		"bank_code":               cba.BankCode,
+		"branch_code":             cba.BranchCode,
+		"iban":                    cba.IBAN,
+		"country_code":            countryCode,
+		"given_name":              customer.GivenName,
+		"family_name":             customer.FamilyName,
+		"company_name":            customer.CompanyName,
+		"address_line1":           customer.AddressLine1,
+		"address_line2":           customer.AddressLine2,
+		"address_line3":           customer.AddressLine3,
+		"city":                    customer.City,
+		"region":                  customer.Region,
+		"postal_code":             customer.PostalCode,
+		"swedish_identity_number": customer.SwedishIdentityNumber,
+		"scheme":                  scheme,
+	})
+	return c.createMandatePDF(ctx, pdfData, language)
+}
+
+func (c *Client) createMandatePDF(ctx context.Context, pdfData map[string]interface{}, language string) (*MandatePDF, error) {
+	if language != "" {
+		ctx = withRequestHeader(ctx, "Accept-Language", language)
+	}
+
+	wrapper := &mandatePDFWrapper{}
+	err := c.post(ctx, mandatePDFEndpoint, map[string]interface{}{"mandate_pdfs": pdfData}, wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.MandatePDF, err
+}
@@ -0,0 +1,144 @@
+package gocardless
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Logger is used by the client to report retried requests, *log.Logger satisfies it
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Option configures a Client created with New
+type Option func(*options)
+
+type options struct {
+	env         Environment
+	remoteURL   string
+	httpClient  *http.Client
+	apiVersion  string
+	userAgent   string
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
+	logger      Logger
+}
+
+// WithEnvironment selects the GoCardless environment the client connects to, defaults to SandboxEnvironment
+func WithEnvironment(env Environment) Option {
+	return func(o *options) {
+		o.env = env
+	}
+}
+
+// WithRemoteURL sends requests to a custom address instead of the environment's, e.g. a proxy or a local mock
+func WithRemoteURL(remoteURL string) Option {
+	return func(o *options) {
+		o.remoteURL = remoteURL
+	}
+}
+
+// WithHTTPClient sends requests using hc instead of a default http.Client
+func WithHTTPClient(hc *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = hc
+	}
+}
+
+// WithAPIVersion overrides the GoCardless-Version header sent with every request
+func WithAPIVersion(version string) Option {
+	return func(o *options) {
+		o.apiVersion = version
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithTimeout limits the time taken by each request, including reading the response body
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetryPolicy retries rate limited and failed requests according to policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithRateLimiter throttles requests client-side using limiter
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *options) {
+		o.rateLimiter = limiter
+	}
+}
+
+// WithLogger reports retried requests to logger
+func WithLogger(logger Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// New instantiate a client with your access token, configured by opts, then
+// use the resource methods to access the API.
+// It returns an *InvalidEnvironment error when the environment is unknown
+func New(accessToken string, opts ...Option) (*Client, error) {
+	o := &options{
+		env:        SandboxEnvironment,
+		apiVersion: apiVersion,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	c := &Client{
+		AccessToken: accessToken,
+		RemoteURL:   o.remoteURL,
+		RetryPolicy: o.retryPolicy,
+		RateLimiter: o.rateLimiter,
+		apiVersion:  o.apiVersion,
+		userAgent:   o.userAgent,
+		logger:      o.logger,
+		httpClient:  o.httpClient,
+	}
+
+	if c.RemoteURL == "" {
+		switch o.env {
+		case SandboxEnvironment:
+			c.RemoteURL = baseSandboxURL
+		case LiveEnvironment:
+			c.RemoteURL = baseLiveURL
+		default:
+			return nil, &InvalidEnvironment{Environment: o.env}
+		}
+	} else {
+		if _, err := url.Parse(c.RemoteURL); err != nil {
+			return nil, err
+		}
+		if !strings.HasSuffix(c.RemoteURL, "/") {
+			c.RemoteURL += "/"
+		}
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{}
+	}
+	if o.timeout > 0 {
+		// copy the http.Client so the timeout does not leak to its other users
+		hc := *c.httpClient
+		hc.Timeout = o.timeout
+		c.httpClient = &hc
+	}
+	return c, nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -58,6 +59,35 @@ type (
 		Payments []*Payment `json:"payments"`
 		Meta     Meta       `json:"meta,omitempty"`
 	}
+
+	// PaymentListParams parameters to filter and paginate the list of payments
+	PaymentListParams struct {
+		ListParams
+		// ChargeDate filters payments by charge date
+		ChargeDate DateRange
+		// CreatedAt filters payments by creation time
+		CreatedAt TimeRange
+		// Creditor only returns payments of this creditor ID
+		Creditor string
+		// Currency only returns payments in this currency
+		Currency string
+		// Customer only returns payments of this customer ID
+		Customer string
+		// Mandate only returns payments collected against this mandate ID
+		Mandate string
+		// Scheme only returns payments of this scheme, e.g. bacs
+		Scheme string
+		// Status only returns payments in this status, e.g. confirmed
+		Status string
+		// Subscription only returns payments created by this subscription ID
+		Subscription string
+	}
+
+	// PaymentIterator iterates over a list of payments, fetching further pages as needed
+	PaymentIterator struct {
+		pager
+		page []*Payment
+	}
 )
 
 func (p *Payment) String() string {
@@ -85,7 +115,7 @@ func (p *Payment) AddMetadata(key, value string) {
 func (c *Client) CreatePayment(ctx context.Context, payment *Payment) error {
 	paymentReq := &paymentWrapper{payment}
 
-	err := c.post(ctx, paymentEndpoint, paymentReq, paymentReq)
+	err := c.create(ctx, paymentEndpoint, paymentReq, paymentReq)
 	if err != nil {
 		return err
 	}
@@ -97,15 +127,65 @@ func (c *Client) CreatePayment(ctx context.Context, payment *Payment) error {
 //
 // Relative endpoint: GET /payments
 func (c *Client) GetPayments(ctx context.Context) (*PaymentListResponse, error) {
+	return c.GetPaymentsWithParams(ctx, nil)
+}
+
+// GetPaymentsWithParams is GetPayments with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /payments
+func (c *Client) GetPaymentsWithParams(ctx context.Context, params *PaymentListParams) (*PaymentListResponse, error) {
 	list := &PaymentListResponse{}
 
-	err := c.get(ctx, paymentEndpoint, list)
+	err := c.get(ctx, listPath(paymentEndpoint, params.values()), list)
 	if err != nil {
 		return nil, err
 	}
 	return list, err
 }
 
+func (params *PaymentListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.ChargeDate.setValues(query, "charge_date")
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "creditor", params.Creditor)
+	setString(query, "currency", params.Currency)
+	setString(query, "customer", params.Customer)
+	setString(query, "mandate", params.Mandate)
+	setString(query, "scheme", params.Scheme)
+	setString(query, "status", params.Status)
+	setString(query, "subscription", params.Subscription)
+	return query
+}
+
+// Payments returns an iterator over all your payments matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /payments
+func (c *Client) Payments(ctx context.Context, params *PaymentListParams) *PaymentIterator {
+	it := &PaymentIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &PaymentListResponse{}
+		if err := c.get(ctx, listPath(paymentEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Payments
+		return len(list.Payments), list.Meta, nil
+	})
+	return it
+}
+
+// Payment returns the current payment, or nil unless the last call to Next returned true
+func (it *PaymentIterator) Payment() *Payment {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
 // GetPayment retrieves the details of an existing payment.
 //
 // Relative endpoint: GET /payments/PM123
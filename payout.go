@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -65,6 +66,31 @@ type (
 		Payouts []*Payout `json:"payouts"`
 		Meta    Meta      `json:"meta,omitempty"`
 	}
+
+	// PayoutListParams parameters to filter and paginate the list of payouts
+	PayoutListParams struct {
+		ListParams
+		// CreatedAt filters payouts by creation time
+		CreatedAt TimeRange
+		// Creditor only returns payouts of this creditor ID
+		Creditor string
+		// CreditorBankAccount only returns payouts sent to this creditor bank account ID
+		CreditorBankAccount string
+		// Currency only returns payouts in this currency
+		Currency string
+		// PayoutType only returns payouts of this type, merchant or partner
+		PayoutType string
+		// Reference only returns payouts with this reference
+		Reference string
+		// Status only returns payouts in this status, e.g. paid
+		Status string
+	}
+
+	// PayoutIterator iterates over a list of payouts, fetching further pages as needed
+	PayoutIterator struct {
+		pager
+		page []*Payout
+	}
 )
 
 func (p *Payout) String() string {
@@ -81,15 +107,63 @@ func (p *Payout) AddMetadata(key, value string) {
 //
 // Relative endpoint: GET /payouts
 func (c *Client) GetPayouts(ctx context.Context) (*PayoutListResponse, error) {
+	return c.GetPayoutsWithParams(ctx, nil)
+}
+
+// GetPayoutsWithParams is GetPayouts with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /payouts
+func (c *Client) GetPayoutsWithParams(ctx context.Context, params *PayoutListParams) (*PayoutListResponse, error) {
 	list := &PayoutListResponse{}
 
-	err := c.get(ctx, payoutEndpoint, list)
+	err := c.get(ctx, listPath(payoutEndpoint, params.values()), list)
 	if err != nil {
 		return nil, err
 	}
 	return list, err
 }
 
+func (params *PayoutListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "creditor", params.Creditor)
+	setString(query, "creditor_bank_account", params.CreditorBankAccount)
+	setString(query, "currency", params.Currency)
+	setString(query, "payout_type", params.PayoutType)
+	setString(query, "reference", params.Reference)
+	setString(query, "status", params.Status)
+	return query
+}
+
+// Payouts returns an iterator over all your payouts matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /payouts
+func (c *Client) Payouts(ctx context.Context, params *PayoutListParams) *PayoutIterator {
+	it := &PayoutIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &PayoutListResponse{}
+		if err := c.get(ctx, listPath(payoutEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Payouts
+		return len(list.Payouts), list.Meta, nil
+	})
+	return it
+}
+
+// Payout returns the current payout, or nil unless the last call to Next returned true
+func (it *PayoutIterator) Payout() *Payout {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
 // GetPayout retrieves the details of an existing payout.
 //
 // Relative endpoint: GET /payouts/PO123
@@ -0,0 +1,146 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+const (
+	payoutItemEndpoint = "payout_items"
+)
+
+// Payout item types, the kind of money movement a PayoutItem represents
+const (
+	// PayoutItemTypePaymentPaidOut a payment collected and paid out
+	PayoutItemTypePaymentPaidOut = "payment_paid_out"
+	// PayoutItemTypePaymentFailed a payment which failed after being paid out, deducted from the payout
+	PayoutItemTypePaymentFailed = "payment_failed"
+	// PayoutItemTypePaymentChargedBack a payment charged back by the customer, deducted from the payout
+	PayoutItemTypePaymentChargedBack = "payment_charged_back"
+	// PayoutItemTypePaymentRefunded a payment refunded before it was paid out
+	PayoutItemTypePaymentRefunded = "payment_refunded"
+	// PayoutItemTypeRefund a refund, deducted from the payout
+	PayoutItemTypeRefund = "refund"
+	// PayoutItemTypeRefundFundsReturned a refund which was returned to the creditor
+	PayoutItemTypeRefundFundsReturned = "refund_funds_returned"
+	// PayoutItemTypeGoCardlessFee the fees charged by GoCardless, deducted from the payout
+	PayoutItemTypeGoCardlessFee = "gocardless_fee"
+	// PayoutItemTypeAppFee the fees charged by an OAuth app, deducted from the payout
+	PayoutItemTypeAppFee = "app_fee"
+	// PayoutItemTypeRevenueShare a share of the fees paid to a partner
+	PayoutItemTypeRevenueShare = "revenue_share"
+	// PayoutItemTypeSurchargeFee the surcharge fees charged by GoCardless, e.g. for failed payments
+	PayoutItemTypeSurchargeFee = "surcharge_fee"
+)
+
+type (
+	// PayoutItem objects represent the payments, refunds and fees which make up a payout
+	PayoutItem struct {
+		// Amount the amount of the item as a decimal string in major currency units, e.g. "45.0".
+		// Deductions from the payout, such as refunds and fees, are negative
+		Amount string `json:"amount"`
+		// Type the kind of item, see the PayoutItemType constants
+		Type string `json:"type"`
+		// Taxes the taxes charged on fees, the items of type gocardless_fee, app_fee and surcharge_fee
+		Taxes []*PayoutItemTax `json:"taxes,omitempty"`
+		// Links to the payment, mandate and refund of the item
+		Links payoutItemLinks `json:"links"`
+	}
+	payoutItemLinks struct {
+		PaymentID string `json:"payment,omitempty"`
+		MandateID string `json:"mandate,omitempty"`
+		RefundID  string `json:"refund,omitempty"`
+	}
+	// PayoutItemTax the tax charged on a fee
+	PayoutItemTax struct {
+		// Amount the amount of tax as a decimal string in the currency of the payout
+		Amount string `json:"amount"`
+		// Currency ISO 4217 code of the currency of the payout
+		Currency string `json:"currency"`
+		// DestinationAmount the amount of tax paid to the tax authorities, in DestinationCurrency
+		DestinationAmount string `json:"destination_amount,omitempty"`
+		// DestinationCurrency ISO 4217 code of the currency tax is paid in to the tax authorities
+		DestinationCurrency string `json:"destination_currency,omitempty"`
+		// ExchangeRate the rate used to convert Amount to DestinationAmount
+		ExchangeRate string `json:"exchange_rate,omitempty"`
+		// TaxRateID the ID of the tax rate applied, e.g. GB_VAT_1
+		TaxRateID string `json:"tax_rate_id,omitempty"`
+	}
+
+	// PayoutItemListResponse a List response of PayoutItem instances
+	PayoutItemListResponse struct {
+		PayoutItems []*PayoutItem `json:"payout_items"`
+		Meta        Meta          `json:"meta,omitempty"`
+	}
+
+	// PayoutItemListParams parameters to paginate the list of payout items
+	PayoutItemListParams struct {
+		ListParams
+		// Include2020TaxCutover includes the taxes of fees charged before the 2020 tax cutover,
+		// which were invoiced instead of being itemised
+		Include2020TaxCutover bool
+	}
+
+	// PayoutItemIterator iterates over a list of payout items, fetching further pages as needed
+	PayoutItemIterator struct {
+		pager
+		page []*PayoutItem
+	}
+)
+
+func (pi *PayoutItem) String() string {
+	bs, _ := json.Marshal(pi)
+	return string(bs)
+}
+
+// GetPayoutItems returns a cursor-paginated list of the items which make up a payout,
+// params may be nil.
+//
+// Relative endpoint: GET /payout_items?payout=PO123
+func (c *Client) GetPayoutItems(ctx context.Context, payoutID string, params *PayoutItemListParams) (*PayoutItemListResponse, error) {
+	list := &PayoutItemListResponse{}
+
+	err := c.get(ctx, listPath(payoutItemEndpoint, params.values(payoutID)), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *PayoutItemListParams) values(payoutID string) url.Values {
+	query := url.Values{}
+	if params != nil {
+		query = params.ListParams.values()
+		if params.Include2020TaxCutover {
+			query.Set("include_2020_tax_cutover", "true")
+		}
+	}
+	query.Set("payout", payoutID)
+	return query
+}
+
+// PayoutItems returns an iterator over all the items which make up a payout, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /payout_items?payout=PO123
+func (c *Client) PayoutItems(ctx context.Context, payoutID string, params *PayoutItemListParams) *PayoutItemIterator {
+	it := &PayoutItemIterator{}
+	it.pager = newPager(ctx, params.values(payoutID), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &PayoutItemListResponse{}
+		if err := c.get(ctx, listPath(payoutItemEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.PayoutItems
+		return len(list.PayoutItems), list.Meta, nil
+	})
+	return it
+}
+
+// PayoutItem returns the current payout item, or nil unless the last call to Next returned true
+func (it *PayoutItemIterator) PayoutItem() *PayoutItem {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
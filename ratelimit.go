@@ -0,0 +1,93 @@
+package gocardless
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRateLimit the number of requests GoCardless allows per access token and minute
+	DefaultRateLimit = 1000
+
+	rateLimitWindow = time.Minute
+)
+
+// RateLimiter is a client-side token bucket which makes requests wait for the next rate limit window once the
+// current one is used up, instead of letting them fail with 429 Too Many Requests. The bucket is kept in sync
+// with the RateLimit-Remaining and RateLimit-Reset headers of the responses.
+// A RateLimiter is safe for concurrent use and may be shared by several clients using the same access token
+type RateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// NewRateLimiter creates a rate limiter allowing limit requests per minute, DefaultRateLimit when limit is not positive
+func NewRateLimiter(limit int) *RateLimiter {
+	if limit <= 0 {
+		limit = DefaultRateLimit
+	}
+	return &RateLimiter{limit: limit}
+}
+
+// Wait blocks until a request may be sent without exceeding the rate limit, or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		if !now.Before(l.reset) {
+			// a new window has started
+			l.remaining = l.limit
+			l.reset = now.Add(rateLimitWindow)
+		}
+		if l.remaining > 0 {
+			l.remaining--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := l.reset.Sub(now)
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// update synchronises the bucket with the rate limit headers of a response
+func (l *RateLimiter) update(resp *Response) {
+	if resp.Header.Get(rateLimitRemainingHeader) == "" {
+		return
+	}
+	remaining := resp.RateLimitRemaining()
+	reset := resp.RateReset()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit := resp.RateLimit(); limit > 0 {
+		l.limit = limit
+	}
+
+	switch {
+	case reset.After(l.reset):
+		// the response belongs to a window the limiter has not seen yet
+		l.reset = reset
+		l.remaining = remaining
+	case reset.IsZero() || reset.After(time.Now()):
+		// the reset advertised by the API is more accurate than the local estimate,
+		// responses of requests sent concurrently may report more remaining requests than are left
+		if !reset.IsZero() {
+			l.reset = reset
+		}
+		if remaining < l.remaining {
+			l.remaining = remaining
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterConcurrentWait(t *testing.T) {
+	limiter := NewRateLimiter(5)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sent    int
+		blocked int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := limiter.Wait(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				sent++
+			case errors.Is(err, context.DeadlineExceeded):
+				blocked++
+			default:
+				t.Errorf("Wait() = %v, want nil or context.DeadlineExceeded", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if sent != 5 || blocked != 15 {
+		t.Errorf("got %d sent and %d blocked, want 5 and 15", sent, blocked)
+	}
+}
+
+func TestRateLimiterFollowsHeaders(t *testing.T) {
+	reset := time.Now().Add(time.Minute).UTC().Format(time.RFC1123)
+
+	var (
+		mu        sync.Mutex
+		remaining = 4
+		served    int
+	)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		served++
+		remaining--
+		w.Header().Set(rateLimitHeader, "1000")
+		w.Header().Set(rateLimitRemainingHeader, strconv.Itoa(remaining))
+		w.Header().Set(rateLimitResetHeader, reset)
+		w.Write([]byte(`{"customers":{"id":"CU123"}}`))
+	}), WithRateLimiter(NewRateLimiter(DefaultRateLimit)))
+
+	// the first response leaves 3 requests in the window, far fewer than the limiter assumed
+	if _, err := c.GetCustomer(context.Background(), "CU123"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		blocked int
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetCustomer(ctx, "CU123")
+			if errors.Is(err, context.DeadlineExceeded) {
+				mu.Lock()
+				blocked++
+				mu.Unlock()
+			} else if err != nil {
+				t.Errorf("GetCustomer() = %v, want nil or context.DeadlineExceeded", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if served != 4 || blocked != 17 {
+		t.Errorf("got %d served and %d blocked, want 4 and 17", served, blocked)
+	}
+}
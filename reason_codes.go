@@ -0,0 +1,20 @@
+package gocardless
+
+// The reasonCodes table is generated from reason_codes.txt, which lists the Bacs codes of the ARUDD, ADDACS
+// and AUDDIS report specifications and the SEPA codes of the ISO 20022 external code sets.
+// Edit reason_codes.txt, then run go generate to update reason_codes_table.go
+//
+//go:generate go run gen_reason_codes.go
+
+// ReasonCodeDescription returns the description of a Bacs or SEPA reason code, e.g. ARUDD-0 or AM04,
+// and whether the code is known
+func ReasonCodeDescription(code string) (string, bool) {
+	description, ok := reasonCodes[code]
+	return description, ok
+}
+
+// ReasonCodeDescription returns the description of the scheme reason code of the event,
+// or an empty string when it has none or the code is not known
+func (e *Event) ReasonCodeDescription() string {
+	return reasonCodes[e.Details.ReasonCode]
+}
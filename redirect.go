@@ -72,7 +72,7 @@ func (r *Redirect) AddMetadata(key, value string) {
 func (c *Client) CreateRedirect(ctx context.Context, redirect *Redirect) error {
 	redirectReq := &redirectWrapper{redirect}
 
-	err := c.post(ctx, redirectEndpoint, redirectReq, redirectReq)
+	err := c.create(ctx, redirectEndpoint, redirectReq, redirectReq)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,204 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	refundEndpoint = "refunds"
+)
+
+type (
+	// Refund objects represent (partial) refunds of a payment back to the customer.
+	Refund struct {
+		// ID is a unique identifier, beginning with "RF".
+		ID string `json:"id,omitempty"`
+		// Amount in pence (GBP), cents (AUD/EUR), öre (SEK), or øre (DKK).
+		// e.g 1000 is 10 GBP in pence
+		Amount int `json:"amount"`
+		// CreatedAt is a fixed timestamp, recording when the refund was created.
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+		// Currency currency code, set to the currency of the refunded payment
+		Currency string `json:"currency,omitempty"`
+		// Reference An optional refund reference, displayed on your customer’s bank statement
+		Reference string `json:"reference,omitempty"`
+		// Status status of refund.
+		Status string `json:"status,omitempty"`
+		// TotalAmountConfirmation Total expected refunded amount in pence/cents/öre/øre, including this refund.
+		// Used to prevent unintended duplicate refunds, the refund is rejected when it does not match
+		// the amount already refunded from the payment plus the amount of this refund
+		TotalAmountConfirmation int `json:"total_amount_confirmation,omitempty"`
+		// foreign exchange info
+		FX *fxInfo `json:"fx,omitempty"`
+		// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+		// characters and values up to 500 characters.
+		Metadata map[string]string `json:"metadata,omitempty"`
+		// Links to payment and mandate
+		Links refundLinks `json:"links"`
+	}
+	refundLinks struct {
+		PaymentID string `json:"payment,omitempty"`
+		MandateID string `json:"mandate,omitempty"`
+	}
+	// refundWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	refundWrapper struct {
+		Refund *Refund `json:"refunds"`
+	}
+
+	// RefundListResponse a List response of Refund instances
+	RefundListResponse struct {
+		Refunds []*Refund `json:"refunds"`
+		Meta    Meta      `json:"meta,omitempty"`
+	}
+
+	// RefundListParams parameters to filter and paginate the list of refunds
+	RefundListParams struct {
+		ListParams
+		// CreatedAt filters refunds by creation time
+		CreatedAt TimeRange
+		// Mandate only returns refunds of payments collected against this mandate ID
+		Mandate string
+		// Payment only returns refunds of this payment ID
+		Payment string
+		// RefundType only returns refunds of this type, payment or mandate
+		RefundType string
+	}
+
+	// RefundIterator iterates over a list of refunds, fetching further pages as needed
+	RefundIterator struct {
+		pager
+		page []*Refund
+	}
+)
+
+func (r *Refund) String() string {
+	bs, _ := json.Marshal(r)
+	return string(bs)
+}
+
+// NewRefund instantiate new refund object, totalAmountConfirmation is the amount refunded from
+// the payment once this refund is created, i.e. the amount already refunded plus amount
+func NewRefund(amount, totalAmountConfirmation int, paymentID string) *Refund {
+	return &Refund{
+		Amount:                  amount,
+		TotalAmountConfirmation: totalAmountConfirmation,
+		Links:                   refundLinks{PaymentID: paymentID},
+	}
+}
+
+// AddMetadata adds new metadata item to refund object
+func (r *Refund) AddMetadata(key, value string) {
+	if r.Metadata == nil {
+		r.Metadata = make(map[string]string)
+	}
+	r.Metadata[key] = value
+}
+
+// CreateRefund creates a new refund object.
+//
+// Relative endpoint: POST /refunds
+func (c *Client) CreateRefund(ctx context.Context, refund *Refund) error {
+	refundReq := &refundWrapper{refund}
+
+	err := c.create(ctx, refundEndpoint, refundReq, refundReq)
+	if err != nil {
+		return err
+	}
+
+	return err
+}
+
+// GetRefunds returns a cursor-paginated list of your refunds.
+//
+// Relative endpoint: GET /refunds
+func (c *Client) GetRefunds(ctx context.Context) (*RefundListResponse, error) {
+	return c.GetRefundsWithParams(ctx, nil)
+}
+
+// GetRefundsWithParams is GetRefunds with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /refunds
+func (c *Client) GetRefundsWithParams(ctx context.Context, params *RefundListParams) (*RefundListResponse, error) {
+	list := &RefundListResponse{}
+
+	err := c.get(ctx, listPath(refundEndpoint, params.values()), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *RefundListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "mandate", params.Mandate)
+	setString(query, "payment", params.Payment)
+	setString(query, "refund_type", params.RefundType)
+	return query
+}
+
+// Refunds returns an iterator over all your refunds matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /refunds
+func (c *Client) Refunds(ctx context.Context, params *RefundListParams) *RefundIterator {
+	it := &RefundIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &RefundListResponse{}
+		if err := c.get(ctx, listPath(refundEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Refunds
+		return len(list.Refunds), list.Meta, nil
+	})
+	return it
+}
+
+// Refund returns the current refund, or nil unless the last call to Next returned true
+func (it *RefundIterator) Refund() *Refund {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// GetRefund retrieves the details of an existing refund.
+//
+// Relative endpoint: GET /refunds/RF123
+func (c *Client) GetRefund(ctx context.Context, id string) (*Refund, error) {
+	wrapper := &refundWrapper{}
+
+	err := c.get(ctx, fmt.Sprintf(`%s/%s`, refundEndpoint, id), wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.Refund, err
+}
+
+// UpdateRefund Updates a refund object. Only the metadata parameter is allowed.
+//
+// Relative endpoint: PUT /refunds/RF123
+func (c *Client) UpdateRefund(ctx context.Context, refund *Refund) error {
+	// allows only metadata
+	refundMeta := map[string]interface{}{
+		"refunds": map[string]interface{}{
+			"metadata": refund.Metadata,
+		},
+	}
+
+	refundReq := &refundWrapper{refund}
+
+	err := c.put(ctx, fmt.Sprintf(`%s/%s`, refundEndpoint, refund.ID), refundMeta, refundReq)
+	if err != nil {
+		return err
+	}
+	return err
+}
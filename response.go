@@ -1,6 +1,7 @@
 package gocardless
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
@@ -11,6 +12,7 @@ const (
 	rateLimitHeader          = `RateLimit-Limit`
 	rateLimitRemainingHeader = `RateLimit-Remaining`
 	rateLimitResetHeader     = `RateLimit-Reset`
+	requestIDHeader          = `X-Request-Id`
 )
 
 // Response response from the API request, providing access
@@ -34,6 +36,20 @@ type Cursor struct {
 	After string `json:"after"`
 }
 
+type responseHookContextKey struct{}
+
+// WithResponseHook returns a copy of ctx which calls hook with every response received for requests made using it,
+// including attempts that are retried, e.g. to throttle batch jobs on the rate limit headers.
+// The hook is called before the body is read and must not consume it
+func WithResponseHook(ctx context.Context, hook func(*Response)) context.Context {
+	return context.WithValue(ctx, responseHookContextKey{}, hook)
+}
+
+func responseHookFromContext(ctx context.Context) func(*Response) {
+	hook, _ := ctx.Value(responseHookContextKey{}).(func(*Response))
+	return hook
+}
+
 // newResponse creates a new response
 func newResponse(resp *http.Response) *Response {
 	return &Response{resp}
@@ -68,6 +84,11 @@ func (resp *Response) RateReset() time.Time {
 	return value
 }
 
+// RequestID the unique ID GoCardless assigned to the request, quote it when contacting support
+func (resp *Response) RequestID() string {
+	return resp.Header.Get(requestIDHeader)
+}
+
 // bind decodes response and binds it to struct
 func (resp *Response) bind(dst interface{}) error {
 
@@ -77,8 +98,9 @@ func (resp *Response) bind(dst interface{}) error {
 		var errCtn errorContainer
 
 		err := json.NewDecoder(resp.Body).Decode(&errCtn)
-		if err != nil {
-			return err
+		if err != nil || errCtn.Error == nil {
+			// the body is not a GoCardless error, e.g. a gateway error page
+			return &Error{Message: http.StatusText(resp.StatusCode), Code: resp.StatusCode}
 		}
 
 		return errCtn.Error
@@ -0,0 +1,71 @@
+package gocardless
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how requests failing with 429 Too Many Requests or a 5xx status are retried.
+// A 429 response with a RateLimit-Reset header is retried once the rate limit resets, up to a minute later,
+// regardless of MinBackoff and MaxBackoff; set a context deadline to bound that wait.
+// The zero value disables retries
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request, including the first one.
+	// Values below 2 disable retries
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, it is doubled on every subsequent attempt.
+	// Defaults to DefaultRetryPolicy.MinBackoff when zero
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential delay between two attempts, defaults to DefaultRetryPolicy.MaxBackoff when zero.
+	// It does not apply to the wait for the RateLimit-Reset of a 429 response
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy retries a failed request up to twice, waiting between 500ms and 30s between attempts
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// retryable reports whether a response with the given status code should be retried
+func (p RetryPolicy) retryable(attempt, statusCode int) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// backoff returns how long to wait before the next attempt.
+// Rate limited responses wait until the window advertised in the RateLimit-Reset header has passed,
+// other failures use exponential backoff with jitter
+func (p RetryPolicy) backoff(attempt int, resp *Response) time.Duration {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if reset := resp.RateReset(); !reset.IsZero() {
+			if wait := time.Until(reset); wait > 0 {
+				return wait
+			}
+		}
+	}
+
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = DefaultRetryPolicy.MinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryPolicy.MaxBackoff
+	}
+
+	// doubling stops at maxBackoff, so wait can not overflow
+	wait := minBackoff
+	for i := 1; i < attempt && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	// full jitter in the upper half of the window, so concurrent clients do not retry in lockstep
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
@@ -0,0 +1,187 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fastRetryPolicy retries quickly, so the tests do not wait on the default backoff
+var fastRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  2 * time.Millisecond,
+}
+
+// newTestClient returns a client sending its requests to handler
+func newTestClient(t *testing.T, handler http.Handler, opts ...Option) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	c, err := New("token", append([]Option{WithRemoteURL(srv.URL)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestRetryKeepsIdempotencyKey(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		keys   []string
+		bodies []string
+	)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		defer mu.Unlock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		bodies = append(bodies, string(body))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"customers":{"id":"CU123"}}`))
+	}), WithRetryPolicy(fastRetryPolicy))
+
+	customer := NewCustomer("user@example.com", "Frank", "Osborne", "27 Acer Road", "Apt 2", "London", "E8 3GX", "GB")
+	if err := c.CreateCustomer(context.Background(), customer); err != nil {
+		t.Fatal(err)
+	}
+	if customer.ID != "CU123" {
+		t.Errorf("customer ID = %q, want CU123", customer.ID)
+	}
+
+	if len(keys) != 3 {
+		t.Fatalf("got %d attempts, want 3", len(keys))
+	}
+	for i := range keys {
+		if keys[i] == "" || keys[i] != keys[0] {
+			t.Errorf("attempt %d sent Idempotency-Key %q, want %q", i+1, keys[i], keys[0])
+		}
+		if bodies[i] == "" || bodies[i] != bodies[0] {
+			t.Errorf("attempt %d sent body %q, want %q", i+1, bodies[i], bodies[0])
+		}
+	}
+}
+
+func TestRetryWaitsForRateLimitReset(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts []time.Time
+		reset    time.Time
+	)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = append(attempts, time.Now())
+		if len(attempts) == 1 {
+			// the header has a one second precision, so the reset is between one and two seconds away
+			reset = time.Now().Add(2 * time.Second).Truncate(time.Second)
+			w.Header().Set(rateLimitResetHeader, reset.UTC().Format(time.RFC1123))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"customers":{"id":"CU123"}}`))
+	}), WithRetryPolicy(fastRetryPolicy))
+
+	if _, err := c.GetCustomer(context.Background(), "CU123"); err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 2 {
+		t.Fatalf("got %d attempts, want 2", len(attempts))
+	}
+	if attempts[1].Before(reset) {
+		t.Errorf("retried at %s, before the rate limit reset at %s", attempts[1], reset)
+	}
+}
+
+func TestRetryStopsBeforeDeadline(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		mu.Unlock()
+		w.WriteHeader(http.StatusInternalServerError)
+	}), WithRetryPolicy(RetryPolicy{MaxAttempts: 5, MinBackoff: time.Second, MaxBackoff: time.Second}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetCustomer(ctx, "CU123")
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusInternalServerError {
+		t.Fatalf("err = %v, want the 500 error of the last attempt", err)
+	}
+	if attempts != 1 {
+		t.Errorf("got %d attempts, want 1", attempts)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("returned after %s, want without waiting for the deadline", elapsed)
+	}
+}
+
+func TestRetryReturnsRateLimitedExceededError(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		mu.Unlock()
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":{"message":"Rate limit exceeded","type":"invalid_api_usage","code":429,` +
+			`"errors":[{"reason":"rate_limit_exceeded","message":"Rate limit exceeded"}]}}`))
+	}), WithRetryPolicy(fastRetryPolicy))
+
+	_, err := c.GetCustomer(context.Background(), "CU123")
+
+	var rateErr *RateLimitedExceededError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("err = %v, want a *RateLimitedExceededError", err)
+	}
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Error("errors.Is(err, ErrRateLimitExceeded) = false, want true")
+	}
+	if rateErr.Err == nil || rateErr.Err.Type != ErrorTypeInvalidAPIUsage {
+		t.Errorf("rateErr.Err = %v, want the API error", rateErr.Err)
+	}
+	if attempts != fastRetryPolicy.MaxAttempts {
+		t.Errorf("got %d attempts, want %d", attempts, fastRetryPolicy.MaxAttempts)
+	}
+}
+
+func TestRetryBackoffDefaults(t *testing.T) {
+	resp := &Response{&http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}}}
+
+	policy := RetryPolicy{MaxAttempts: 50}
+	if wait := policy.backoff(1, resp); wait < DefaultRetryPolicy.MinBackoff/2 || wait > DefaultRetryPolicy.MinBackoff {
+		t.Errorf("backoff(1) = %s, want within [%s, %s]", wait, DefaultRetryPolicy.MinBackoff/2, DefaultRetryPolicy.MinBackoff)
+	}
+	for _, attempt := range []int{10, 49, 100} {
+		if wait := policy.backoff(attempt, resp); wait < DefaultRetryPolicy.MaxBackoff/2 || wait > DefaultRetryPolicy.MaxBackoff {
+			t.Errorf("backoff(%d) = %s, want within [%s, %s]", attempt, wait, DefaultRetryPolicy.MaxBackoff/2, DefaultRetryPolicy.MaxBackoff)
+		}
+	}
+
+	// the doubling must not overflow without a MaxBackoff
+	policy = RetryPolicy{MaxAttempts: 100, MinBackoff: time.Hour}
+	if wait := policy.backoff(99, resp); wait < DefaultRetryPolicy.MaxBackoff/2 || wait > DefaultRetryPolicy.MaxBackoff {
+		t.Errorf("backoff(99) = %s, want within [%s, %s]", wait, DefaultRetryPolicy.MaxBackoff/2, DefaultRetryPolicy.MaxBackoff)
+	}
+}
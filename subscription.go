@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -11,6 +12,31 @@ const (
 	subscriptionEndpoint = "subscriptions"
 )
 
+// SubscriptionField an updatable field of a subscription, passed to UpdateSubscription to clear it
+type SubscriptionField string
+
+const (
+	// SubscriptionName the name of the subscription
+	SubscriptionName SubscriptionField = "name"
+	// SubscriptionPaymentReference the payment reference of the subscription
+	SubscriptionPaymentReference SubscriptionField = "payment_reference"
+	// SubscriptionAppFee the app fee deducted from each payment
+	SubscriptionAppFee SubscriptionField = "app_fee"
+	// SubscriptionMetadata all the metadata of the subscription
+	SubscriptionMetadata SubscriptionField = "metadata"
+	// SubscriptionRetryIfPossible intelligent retries of failed payments
+	SubscriptionRetryIfPossible SubscriptionField = "retry_if_possible"
+)
+
+// subscriptionUnsetValues the value sent to clear each field
+var subscriptionUnsetValues = map[SubscriptionField]interface{}{
+	SubscriptionName:             nil,
+	SubscriptionPaymentReference: nil,
+	SubscriptionAppFee:           nil,
+	SubscriptionMetadata:         map[string]string{},
+	SubscriptionRetryIfPossible:  false,
+}
+
 type (
 	// Subscription objects represent payments according to a schedule
 	Subscription struct {
@@ -29,6 +55,8 @@ type (
 		Name string `json:"name,omitempty"`
 		// StartDate A future date on which the subscription should start.
 		StartDate *Date `json:"start_date,omitempty"`
+		// EndDate Date on or after which no further payments should be created. Cannot be combined with Count
+		EndDate *Date `json:"end_date,omitempty"`
 		// Number of interval_units between customer charge dates.
 		Interval int `json:"interval,omitempty"`
 		// The total number of payments that should be taken by this subscription.
@@ -40,7 +68,7 @@ type (
 		// Name of the month on which to charge a customer. Must be lowercase. Only applies when the interval_unit is yearly
 		Month int `json:"month,omitempty"`
 		//An optional payment reference.
-		PaymentReference int `json:"payment_reference,omitempty"`
+		PaymentReference string `json:"payment_reference,omitempty"`
 		// The amount to be deducted from each payment as an app fee
 		AppFee int `json:"app_fee,omitempty"`
 		//
@@ -75,6 +103,25 @@ type (
 		Subscriptions []*Subscription `json:"subscriptions"`
 		Meta          Meta            `json:"meta,omitempty"`
 	}
+
+	// SubscriptionListParams parameters to filter and paginate the list of subscriptions
+	SubscriptionListParams struct {
+		ListParams
+		// CreatedAt filters subscriptions by creation time
+		CreatedAt TimeRange
+		// Customer only returns subscriptions of this customer ID
+		Customer string
+		// Mandate only returns subscriptions of this mandate ID
+		Mandate string
+		// Status only returns subscriptions in these statuses, e.g. active
+		Status []string
+	}
+
+	// SubscriptionIterator iterates over a list of subscriptions, fetching further pages as needed
+	SubscriptionIterator struct {
+		pager
+		page []*Subscription
+	}
 )
 
 func (s *Subscription) String() string {
@@ -103,7 +150,7 @@ func (s *Subscription) AddMetadata(key, value string) {
 func (c *Client) CreateSubscription(ctx context.Context, subscription *Subscription) error {
 	subscriptionReq := &subscriptionWrapper{subscription}
 
-	err := c.post(ctx, subscriptionEndpoint, subscriptionReq, subscriptionReq)
+	err := c.create(ctx, subscriptionEndpoint, subscriptionReq, subscriptionReq)
 	if err != nil {
 		return err
 	}
@@ -115,15 +162,60 @@ func (c *Client) CreateSubscription(ctx context.Context, subscription *Subscript
 //
 // Relative endpoint: GET /subscriptions
 func (c *Client) GetSubscriptions(ctx context.Context) (*SubscriptionListResponse, error) {
+	return c.GetSubscriptionsWithParams(ctx, nil)
+}
+
+// GetSubscriptionsWithParams is GetSubscriptions with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /subscriptions
+func (c *Client) GetSubscriptionsWithParams(ctx context.Context, params *SubscriptionListParams) (*SubscriptionListResponse, error) {
 	list := &SubscriptionListResponse{}
 
-	err := c.get(ctx, subscriptionEndpoint, list)
+	err := c.get(ctx, listPath(subscriptionEndpoint, params.values()), list)
 	if err != nil {
 		return nil, err
 	}
 	return list, err
 }
 
+func (params *SubscriptionListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "customer", params.Customer)
+	setString(query, "mandate", params.Mandate)
+	setList(query, "status", params.Status)
+	return query
+}
+
+// Subscriptions returns an iterator over all your subscriptions matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /subscriptions
+func (c *Client) Subscriptions(ctx context.Context, params *SubscriptionListParams) *SubscriptionIterator {
+	it := &SubscriptionIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &SubscriptionListResponse{}
+		if err := c.get(ctx, listPath(subscriptionEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Subscriptions
+		return len(list.Subscriptions), list.Meta, nil
+	})
+	return it
+}
+
+// Subscription returns the current subscription, or nil unless the last call to Next returned true
+func (it *SubscriptionIterator) Subscription() *Subscription {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
 // GetSubscription retrieves the details of an existing subscription.
 //
 // Relative endpoint: GET /subscriptions/SB123
@@ -137,20 +229,46 @@ func (c *Client) GetSubscription(ctx context.Context, id string) (*Subscription,
 	return wrapper.Subscription, err
 }
 
-// UpdateSubscription Updates a sSubscription object. Supports all of the fields supported when creating a subscription.
+// UpdateSubscription Updates a subscription object. Sends the amount, name, payment reference, app fee, metadata and
+// intelligent retries of the subscription, fields left at their zero value are not changed.
+// Pass the fields to clear explicitly as unset, e.g. UpdateSubscription(ctx, sub, SubscriptionAppFee)
 //
 // Relative endpoint: PUT /subscriptions/SB123
-func (c *Client) UpdateSubscription(ctx context.Context, subscription *Subscription) error {
-	// allows only metadata
-	subscriptionMeta := map[string]interface{}{
-		"subscriptions": map[string]interface{}{
-			"metadata": subscription.Metadata,
-		},
+func (c *Client) UpdateSubscription(ctx context.Context, subscription *Subscription, unset ...SubscriptionField) error {
+	fields := map[string]interface{}{}
+	if subscription.Amount != 0 {
+		fields["amount"] = subscription.Amount
+	}
+	if subscription.Name != "" {
+		fields[string(SubscriptionName)] = subscription.Name
+	}
+	if subscription.PaymentReference != "" {
+		fields[string(SubscriptionPaymentReference)] = subscription.PaymentReference
+	}
+	if subscription.AppFee != 0 {
+		fields[string(SubscriptionAppFee)] = subscription.AppFee
+	}
+	if subscription.Metadata != nil {
+		fields[string(SubscriptionMetadata)] = subscription.Metadata
+	}
+	if subscription.Retry {
+		fields[string(SubscriptionRetryIfPossible)] = true
+	}
+	for _, field := range unset {
+		value, ok := subscriptionUnsetValues[field]
+		if !ok {
+			return fmt.Errorf("gocardless: subscription field %s can not be unset", field)
+		}
+		fields[string(field)] = value
+	}
+
+	subscriptionData := map[string]interface{}{
+		"subscriptions": fields,
 	}
 
 	subscriptionReq := &subscriptionWrapper{subscription}
 
-	err := c.put(ctx, fmt.Sprintf(`%s/%s`, subscriptionEndpoint, subscription.ID), subscriptionMeta, subscriptionReq)
+	err := c.put(ctx, fmt.Sprintf(`%s/%s`, subscriptionEndpoint, subscription.ID), subscriptionData, subscriptionReq)
 	if err != nil {
 		return err
 	}
@@ -177,19 +295,35 @@ func (c *Client) CancelSubscription(ctx context.Context, subscription *Subscript
 	return err
 }
 
-// PauseSubscription pauses a active subscription
+// PauseSubscription pauses a active subscription until it is resumed
 //
 // Relative endpoint: POST /subscriptions/SU123/actions/pause
 func (c *Client) PauseSubscription(ctx context.Context, subscription *Subscription) error {
-	// allows only metadata
-	subscriptionMeta := map[string]interface{}{
-		"subscriptions": map[string]interface{}{
-			"metadata": subscription.Metadata,
-		},
+	return c.pauseSubscription(ctx, subscription, 0)
+}
+
+// PauseSubscriptionForCycles pauses a active subscription for pauseCycles billing cycles,
+// after which it resumes automatically
+//
+// Relative endpoint: POST /subscriptions/SU123/actions/pause
+func (c *Client) PauseSubscriptionForCycles(ctx context.Context, subscription *Subscription, pauseCycles int) error {
+	return c.pauseSubscription(ctx, subscription, pauseCycles)
+}
+
+func (c *Client) pauseSubscription(ctx context.Context, subscription *Subscription, pauseCycles int) error {
+	pauseData := map[string]interface{}{}
+	if subscription.Metadata != nil {
+		pauseData["metadata"] = subscription.Metadata
+	}
+	if pauseCycles > 0 {
+		pauseData["pause_cycles"] = pauseCycles
+	}
+	subscriptionData := map[string]interface{}{
+		"data": pauseData,
 	}
 
 	wrapper := &subscriptionWrapper{subscription}
-	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/pause`, subscriptionEndpoint, subscription.ID), subscriptionMeta, wrapper)
+	err := c.post(ctx, fmt.Sprintf(`%s/%s/actions/pause`, subscriptionEndpoint, subscription.ID), subscriptionData, wrapper)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,87 @@
+package gocardless
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestPauseSubscriptionBody(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"subscriptions":{"id":"SB123","status":"paused"}}`))
+
+	var req struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	if err := c.PauseSubscription(context.Background(), &Subscription{ID: "SB123"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.Data) != 0 {
+		t.Errorf("sent %s, want no metadata nor pause_cycles", body)
+	}
+
+	req.Data = nil
+	subscription := &Subscription{ID: "SB123", Metadata: map[string]string{"reason": "holiday"}}
+	if err := c.PauseSubscriptionForCycles(context.Background(), subscription, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Data["pause_cycles"] != float64(2) || req.Data["metadata"] == nil {
+		t.Errorf("sent %s, want metadata and pause_cycles", body)
+	}
+	if subscription.Status != "paused" {
+		t.Errorf("status = %q, want paused", subscription.Status)
+	}
+}
+
+func TestUpdateSubscriptionBody(t *testing.T) {
+	var body []byte
+	c := newTestClient(t, recordBody(&body, `{"subscriptions":{"id":"SB123"}}`))
+
+	var req struct {
+		Subscriptions map[string]interface{} `json:"subscriptions"`
+	}
+
+	subscription := &Subscription{ID: "SB123", Amount: 2000, Name: "Gold", Retry: true}
+	if err := c.UpdateSubscription(context.Background(), subscription); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	sent := req.Subscriptions
+	if len(sent) != 3 || sent["amount"] != float64(2000) || sent["name"] != "Gold" || sent["retry_if_possible"] != true {
+		t.Errorf("sent %s, want only amount, name and retry_if_possible", body)
+	}
+
+	req.Subscriptions = nil
+	subscription = &Subscription{ID: "SB123", Amount: 2500}
+	if err := c.UpdateSubscription(context.Background(), subscription, SubscriptionName, SubscriptionAppFee, SubscriptionMetadata); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		t.Fatal(err)
+	}
+	sent = req.Subscriptions
+	name, nameSent := sent["name"]
+	appFee, appFeeSent := sent["app_fee"]
+	metadata, _ := sent["metadata"].(map[string]interface{})
+	if len(sent) != 4 || sent["amount"] != float64(2500) || !nameSent || name != nil || !appFeeSent || appFee != nil ||
+		metadata == nil || len(metadata) != 0 {
+		t.Errorf("sent %s, want the amount, null name and app_fee, and empty metadata", body)
+	}
+
+	body = nil
+	if err := c.UpdateSubscription(context.Background(), subscription, SubscriptionField("amount")); err == nil {
+		t.Error("UpdateSubscription() unsetting amount returned no error")
+	}
+	if body != nil {
+		t.Errorf("sent %s, want no request", body)
+	}
+}
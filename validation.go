@@ -0,0 +1,91 @@
+package gocardless
+
+import (
+	"reflect"
+	"strings"
+)
+
+// validationResources maps the envelope of a request body to the struct it wraps,
+// used to resolve the request pointers of validation errors to Go field names
+var validationResources = map[string]reflect.Type{
+	customerEndpoint:    reflect.TypeOf(Customer{}),
+	bankAccountEndpoint: reflect.TypeOf(CustomerBankAccount{}),
+	mandateEndpoint:     reflect.TypeOf(Mandate{}),
+}
+
+// ValidationErrors returns the messages of a validation_failed error keyed by the Go struct field they relate to,
+// e.g. "Customer.PostalCode", "CustomerBankAccount.IBAN" or "Mandate.Links.CustomerBankAccountID".
+// Details whose request pointer can not be mapped to a struct field are keyed by their API field name,
+// and details not related to any field are keyed by an empty string.
+// It returns nil for any other type of error
+func (err Error) ValidationErrors() map[string][]string {
+	if err.Type != ErrorTypeValidationFailed {
+		return nil
+	}
+
+	fields := make(map[string][]string, len(err.Details))
+	for _, detail := range err.Details {
+		key, ok := structFieldPath(detail.RequestPointer)
+		if !ok {
+			key = detail.Field
+		}
+		fields[key] = append(fields[key], detail.Message)
+	}
+	return fields
+}
+
+// structFieldPath resolves a JSON pointer such as /customers/postal_code to the matching
+// struct field path, Customer.PostalCode, following the json tags of the resource types
+func structFieldPath(pointer string) (string, bool) {
+	if pointer == "" {
+		return "", false
+	}
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+
+	t, ok := validationResources[unescapePointerToken(tokens[0])]
+	if !ok {
+		return "", false
+	}
+
+	path := []string{t.Name()}
+	for _, token := range tokens[1:] {
+		token = unescapePointerToken(token)
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		switch t.Kind() {
+		case reflect.Map:
+			// e.g. a metadata key
+			path = append(path, token)
+			t = t.Elem()
+		case reflect.Struct:
+			field, ok := fieldByJSONName(t, token)
+			if !ok {
+				return "", false
+			}
+			path = append(path, field.Name)
+			t = field.Type
+		default:
+			return "", false
+		}
+	}
+	return strings.Join(path, "."), true
+}
+
+// fieldByJSONName finds the field of struct type t with the given json name
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tag == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// unescapePointerToken decodes the ~1 and ~0 escapes of a JSON pointer token, see RFC 6901
+func unescapePointerToken(token string) string {
+	return strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+}
@@ -0,0 +1,42 @@
+package gocardless
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidationErrors(t *testing.T) {
+	err := Error{
+		Type: ErrorTypeValidationFailed,
+		Details: []*ErrorDetail{
+			{Field: "postal_code", Message: "is invalid", RequestPointer: "/customers/postal_code"},
+			{Field: "postal_code", Message: "is too long", RequestPointer: "/customers/postal_code"},
+			{Field: "iban", Message: "is invalid", RequestPointer: "/customer_bank_accounts/iban"},
+			{Field: "customer_bank_account", Message: "does not exist", RequestPointer: "/mandates/links/customer_bank_account"},
+			{Field: "metadata", Message: "is too long", RequestPointer: "/customers/metadata/order~1id"},
+			{Field: "scheme", Message: "is not supported", RequestPointer: "/payments/scheme"},
+			{Field: "unknown", Message: "is invalid", RequestPointer: "/customers/unknown"},
+			{Message: "is invalid"},
+		},
+	}
+	want := map[string][]string{
+		"Customer.PostalCode":                 {"is invalid", "is too long"},
+		"CustomerBankAccount.IBAN":            {"is invalid"},
+		"Mandate.Links.CustomerBankAccountID": {"does not exist"},
+		"Customer.Metadata.order/id":          {"is too long"},
+		"scheme":                              {"is not supported"},
+		"unknown":                             {"is invalid"},
+		"":                                    {"is invalid"},
+	}
+	if got := err.ValidationErrors(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidationErrors() = %v, want %v", got, want)
+	}
+
+	invalidState := Error{
+		Type:    ErrorTypeInvalidState,
+		Details: []*ErrorDetail{{Reason: "mandate_is_inactive", Message: "is inactive"}},
+	}
+	if got := invalidState.ValidationErrors(); got != nil {
+		t.Errorf("ValidationErrors() of an invalid_state error = %v, want nil", got)
+	}
+}
@@ -0,0 +1,59 @@
+package gocardless
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+const (
+	// WebhookSignatureHeader the header carrying the signature of webhook requests
+	WebhookSignatureHeader = `Webhook-Signature`
+)
+
+// ParseWebhook verifies the signature of a webhook body sent by GoCardless with the secret of your webhook endpoint,
+// then parses the events it contains. It returns ErrInvalidSignature when the signature does not match.
+//
+// https://developer.gocardless.com/getting-started/staying-up-to-date-with-webhooks/
+func ParseWebhook(body []byte, signatureHeader, secret string) (*EventList, error) {
+	return ParseWebhookWithSecrets(body, signatureHeader, secret)
+}
+
+// ParseWebhookWithSecrets is like ParseWebhook but accepts bodies signed with any of secrets,
+// e.g. both the current and the new secret while rotating the secret of your webhook endpoint
+func ParseWebhookWithSecrets(body []byte, signatureHeader string, secrets ...string) (*EventList, error) {
+	if !VerifyWebhookSignature(body, signatureHeader, secrets...) {
+		return nil, ErrInvalidSignature
+	}
+
+	list := &EventList{}
+	if err := json.Unmarshal(body, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// VerifyWebhookSignature reports whether signatureHeader is the HMAC-SHA256 of body with any of secrets.
+// The signatures are compared in constant time
+func VerifyWebhookSignature(body []byte, signatureHeader string, secrets ...string) bool {
+	signature, err := hex.DecodeString(strings.TrimSpace(signatureHeader))
+	if err != nil || len(signature) == 0 {
+		return false
+	}
+
+	valid := false
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		// check every secret so the time taken does not reveal which one matched
+		if hmac.Equal(mac.Sum(nil), signature) {
+			valid = true
+		}
+	}
+	return valid
+}
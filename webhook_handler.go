@@ -0,0 +1,241 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// StatusInvalidToken the status GoCardless expects in response to a webhook with an invalid signature
+	StatusInvalidToken = 498
+
+	// maxWebhookBodySize the largest webhook body read, batches hold at most 250 events
+	maxWebhookBodySize = 1 << 20
+)
+
+// WebhookEventHandler handles a single event delivered by a webhook
+type WebhookEventHandler func(ctx context.Context, event *Event) error
+
+// WebhookErrorPolicy controls how a WebhookHandler responds when an event handler fails
+type WebhookErrorPolicy int
+
+const (
+	// WebhookAbortOnError stops processing the batch at the first failed event and responds with
+	// 500 Internal Server Error, so GoCardless delivers the whole batch again later
+	WebhookAbortOnError WebhookErrorPolicy = iota
+	// WebhookContinueOnError processes the remaining events of the batch and responds with 204 No Content,
+	// failed events are only reported to OnError
+	WebhookContinueOnError
+)
+
+// WebhookHandler is an http.Handler receiving GoCardless webhooks. It verifies their signature,
+// then dispatches each event to the handlers registered for its resource type and action, e.g.
+//
+//	h := gocardless.NewWebhookHandler(secret)
+//	h.OnPayment(gocardless.ActionPaymentConfirmed, func(ctx context.Context, event *gocardless.Event) error { ... })
+//	http.Handle("/webhooks/gocardless", h)
+//
+// It responds with 498 when the signature is invalid, 413 when the body is larger than 1 MiB
+// and 204 once the events are handled.
+// A panic in an event handler is recovered and treated as an error
+type WebhookHandler struct {
+	// ErrorPolicy controls the response when an event handler fails, defaults to WebhookAbortOnError
+	ErrorPolicy WebhookErrorPolicy
+	// OnError when set, is called with each event which could not be handled and the error
+	OnError func(event *Event, err error)
+	// Store when set, claims each event before running its handlers, skipping those already claimed or processed,
+	// so the handlers run once per event although GoCardless may deliver it several times, even concurrently
+	Store EventStore
+	// EventTTL how long Store remembers processed events, defaults to DefaultEventTTL
+	EventTTL time.Duration
+	// ClaimTTL how long Store holds the claim on an event while its handlers run, defaults to DefaultClaimTTL.
+	// An event whose processing was interrupted, e.g. by a crash, can only be processed again after it
+	ClaimTTL time.Duration
+
+	secrets  []string
+	mu       sync.RWMutex
+	handlers map[ResourceType]map[Action][]WebhookEventHandler
+}
+
+// NewWebhookHandler creates a webhook handler accepting webhooks signed with any of secrets,
+// pass both the current and the new secret while rotating it
+func NewWebhookHandler(secrets ...string) *WebhookHandler {
+	return &WebhookHandler{
+		secrets:  secrets,
+		handlers: make(map[ResourceType]map[Action][]WebhookEventHandler),
+	}
+}
+
+// On registers fn for the events of resourceType with action, e.g. On(ResourceTypePayments, ActionPaymentConfirmed, fn).
+// An empty action matches every action of the resource type
+func (h *WebhookHandler) On(resourceType ResourceType, action Action, fn WebhookEventHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	actions, ok := h.handlers[resourceType]
+	if !ok {
+		actions = make(map[Action][]WebhookEventHandler)
+		h.handlers[resourceType] = actions
+	}
+	actions[action] = append(actions[action], fn)
+}
+
+// OnPayment registers fn for the payment events with action, an empty action matches every action
+func (h *WebhookHandler) OnPayment(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypePayments, action, fn)
+}
+
+// OnMandate registers fn for the mandate events with action, an empty action matches every action
+func (h *WebhookHandler) OnMandate(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypeMandates, action, fn)
+}
+
+// OnSubscription registers fn for the subscription events with action, an empty action matches every action
+func (h *WebhookHandler) OnSubscription(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypeSubscriptions, action, fn)
+}
+
+// OnPayout registers fn for the payout events with action, an empty action matches every action
+func (h *WebhookHandler) OnPayout(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypePayouts, action, fn)
+}
+
+// OnRefund registers fn for the refund events with action, an empty action matches every action
+func (h *WebhookHandler) OnRefund(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypeRefunds, action, fn)
+}
+
+// OnCreditor registers fn for the creditor events with action, an empty action matches every action
+func (h *WebhookHandler) OnCreditor(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypeCreditors, action, fn)
+}
+
+// OnInstalmentSchedule registers fn for the instalment schedule events with action, an empty action matches every action
+func (h *WebhookHandler) OnInstalmentSchedule(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypeInstalmentSchedules, action, fn)
+}
+
+// OnBillingRequest registers fn for the billing request events with action, an empty action matches every action
+func (h *WebhookHandler) OnBillingRequest(action Action, fn WebhookEventHandler) {
+	h.On(ResourceTypeBillingRequests, action, fn)
+}
+
+// ServeHTTP verifies and handles a webhook request
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	// read one byte past the limit to tell a body of the maximum size from a larger one
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize+1))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxWebhookBodySize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	list, err := ParseWebhookWithSecrets(body, r.Header.Get(WebhookSignatureHeader), h.secrets...)
+	if errors.Is(err, ErrInvalidSignature) {
+		w.WriteHeader(StatusInvalidToken)
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	for _, event := range list.Events {
+		if err := h.process(r.Context(), event); err != nil {
+			h.reportError(event, err)
+			if h.ErrorPolicy == WebhookAbortOnError {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// process dispatches event once it is claimed in Store, then marks it as processed.
+// The claim is released when a handler fails, so the event is processed again when GoCardless retries the webhook
+func (h *WebhookHandler) process(ctx context.Context, event *Event) error {
+	if h.Store == nil {
+		return h.dispatch(ctx, event)
+	}
+
+	claimTTL := h.ClaimTTL
+	if claimTTL == 0 {
+		claimTTL = DefaultClaimTTL
+	}
+	claimed, err := h.Store.Claim(ctx, event.ID, claimTTL)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		// already processed, or being processed by another delivery
+		return nil
+	}
+
+	if err := h.dispatch(ctx, event); err != nil {
+		if releaseErr := h.Store.Release(ctx, event.ID); releaseErr != nil {
+			h.reportError(event, releaseErr)
+		}
+		return err
+	}
+
+	ttl := h.EventTTL
+	if ttl == 0 {
+		ttl = DefaultEventTTL
+	}
+	if err := h.Store.MarkProcessed(ctx, event.ID, ttl); err != nil {
+		// the handlers succeeded and the claim still holds, failing the webhook would only get it delivered again
+		h.reportError(event, err)
+	}
+	return nil
+}
+
+// reportError passes the error of event to OnError, if set
+func (h *WebhookHandler) reportError(event *Event, err error) {
+	if h.OnError != nil {
+		h.OnError(event, err)
+	}
+}
+
+// dispatch calls the handlers registered for event, recovering from their panics
+func (h *WebhookHandler) dispatch(ctx context.Context, event *Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("gocardless: webhook handler for event %s panicked: %v", event.ID, r)
+		}
+	}()
+
+	for _, fn := range h.handlersFor(event) {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handlersFor returns the handlers registered for the action of event, followed by those for any action
+func (h *WebhookHandler) handlersFor(event *Event) []WebhookEventHandler {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	actions := h.handlers[event.ResourceType]
+	var handlers []WebhookEventHandler
+	if event.Action != "" {
+		handlers = append(handlers, actions[event.Action]...)
+	}
+	return append(handlers, actions[""]...)
+}
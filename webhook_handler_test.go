@@ -0,0 +1,133 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testWebhookBatch = `{"events":[` +
+	`{"id":"EV1","resource_type":"payments","action":"failed"},` +
+	`{"id":"EV2","resource_type":"mandates","action":"cancelled"}]}`
+
+// serveWebhook sends body signed with secret to h and returns the response
+func serveWebhook(h http.Handler, method, body, secret string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, "/webhooks", strings.NewReader(body))
+	r.Header.Set(WebhookSignatureHeader, sign(body, secret))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestWebhookHandlerDispatches(t *testing.T) {
+	h := NewWebhookHandler("old", "secret")
+
+	var handled []string
+	record := func(ctx context.Context, event *Event) error {
+		handled = append(handled, event.ID+" "+string(event.Action))
+		return nil
+	}
+	h.OnPayment(ActionPaymentFailed, record)
+	h.OnPayment(ActionPaymentConfirmed, func(ctx context.Context, event *Event) error {
+		t.Errorf("confirmed handler called for %s", event.ID)
+		return nil
+	})
+	h.OnMandate("", record)
+
+	w := serveWebhook(h, http.MethodPost, testWebhookBatch, "secret")
+	if w.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want 204", w.Code)
+	}
+	if got := strings.Join(handled, ","); got != "EV1 failed,EV2 cancelled" {
+		t.Errorf("handled %s, want EV1 failed,EV2 cancelled", got)
+	}
+}
+
+func TestWebhookHandlerStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		secret string
+		want   int
+	}{
+		{"valid", http.MethodPost, testWebhookBatch, "secret", http.StatusNoContent},
+		{"wrong method", http.MethodGet, testWebhookBatch, "secret", http.StatusMethodNotAllowed},
+		{"invalid signature", http.MethodPost, testWebhookBatch, "other", StatusInvalidToken},
+		{"invalid JSON", http.MethodPost, `{"events":`, "secret", http.StatusBadRequest},
+		{"maximum size", http.MethodPost, testWebhookBatch + strings.Repeat(" ", maxWebhookBodySize-len(testWebhookBatch)), "secret", http.StatusNoContent},
+		{"too large", http.MethodPost, testWebhookBatch + strings.Repeat(" ", maxWebhookBodySize), "secret", http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveWebhook(NewWebhookHandler("secret"), tt.method, tt.body, tt.secret)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerErrorPolicy(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	tests := []struct {
+		name    string
+		policy  WebhookErrorPolicy
+		panics  bool
+		want    int
+		handled int
+	}{
+		{"abort on error", WebhookAbortOnError, false, http.StatusInternalServerError, 0},
+		{"continue on error", WebhookContinueOnError, false, http.StatusNoContent, 1},
+		{"abort on panic", WebhookAbortOnError, true, http.StatusInternalServerError, 0},
+		{"continue on panic", WebhookContinueOnError, true, http.StatusNoContent, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewWebhookHandler("secret")
+			h.ErrorPolicy = tt.policy
+
+			var failed []error
+			h.OnError = func(event *Event, err error) {
+				if event.ID != "EV1" {
+					t.Errorf("OnError called for %s, want EV1", event.ID)
+				}
+				failed = append(failed, err)
+			}
+
+			handled := 0
+			h.OnPayment("", func(ctx context.Context, event *Event) error {
+				if tt.panics {
+					panic("boom")
+				}
+				return errFailed
+			})
+			h.OnMandate("", func(ctx context.Context, event *Event) error {
+				handled++
+				return nil
+			})
+
+			w := serveWebhook(h, http.MethodPost, testWebhookBatch, "secret")
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+			if handled != tt.handled {
+				t.Errorf("handled %d events after the failure, want %d", handled, tt.handled)
+			}
+			if len(failed) != 1 {
+				t.Fatalf("OnError called %d times, want 1", len(failed))
+			}
+			if tt.panics {
+				if !strings.Contains(failed[0].Error(), "panicked: boom") {
+					t.Errorf("error = %v, want the recovered panic", failed[0])
+				}
+			} else if !errors.Is(failed[0], errFailed) {
+				t.Errorf("error = %v, want %v", failed[0], errFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package gocardless
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+const testWebhookBody = `{"events":[{"id":"EV123","resource_type":"payments","action":"confirmed","links":{"payment":"PM123"}}]}`
+
+// sign returns the Webhook-Signature header of body signed with secret
+func sign(body, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	signature := sign(testWebhookBody, "secret")
+
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+		secrets   []string
+		want      bool
+	}{
+		{"valid", testWebhookBody, signature, []string{"secret"}, true},
+		{"tampered body", testWebhookBody + " ", signature, []string{"secret"}, false},
+		{"other secret", testWebhookBody, signature, []string{"other"}, false},
+		{"non hex header", testWebhookBody, "not-a-signature", []string{"secret"}, false},
+		{"empty header", testWebhookBody, "", []string{"secret"}, false},
+		{"second secret of rotation", testWebhookBody, signature, []string{"old", "secret"}, true},
+		{"no secrets", testWebhookBody, signature, nil, false},
+		{"empty secret skipped", testWebhookBody, sign(testWebhookBody, ""), []string{""}, false},
+		{"empty secret skipped in rotation", testWebhookBody, sign(testWebhookBody, ""), []string{"", "secret"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyWebhookSignature([]byte(tt.body), tt.signature, tt.secrets...); got != tt.want {
+				t.Errorf("VerifyWebhookSignature() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWebhookWithSecrets(t *testing.T) {
+	list, err := ParseWebhookWithSecrets([]byte(testWebhookBody), sign(testWebhookBody, "new"), "old", "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Events) != 1 {
+		t.Fatalf("got %d events, want 1", len(list.Events))
+	}
+	event := list.Events[0]
+	if event.ID != "EV123" || event.ResourceType != ResourceTypePayments || event.Action != ActionPaymentConfirmed || event.Links.PaymentID != "PM123" {
+		t.Errorf("got event %s", event)
+	}
+
+	if _, err := ParseWebhook([]byte(testWebhookBody), sign(testWebhookBody, "other"), "secret"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("ParseWebhook() with another secret = %v, want ErrInvalidSignature", err)
+	}
+
+	invalid := `{"events":`
+	if _, err := ParseWebhook([]byte(invalid), sign(invalid, "secret"), "secret"); err == nil || errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("ParseWebhook() with invalid JSON = %v, want a decoding error", err)
+	}
+}
@@ -1,21 +1,28 @@
 package gocardless
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/url"
 	"time"
 )
 
+const (
+	eventEndpoint = "events"
+)
+
 type (
 	// Event objects represent events passed by gocardless's webhook notifications
 	Event struct {
-		// ID is a unique identifier, beginning with "PM".
+		// ID is a unique identifier, beginning with "EV".
 		ID string `json:"id,omitempty"`
-		// CreatedAt is a fixed timestamp, recording when the payment was created.
+		// CreatedAt is a fixed timestamp, recording when the event was created.
 		CreatedAt *time.Time `json:"created_at,omitempty"`
 		// ResourceType of the event is associated with
-		ResourceType string `json:"resource_type,omitempty"`
+		ResourceType ResourceType `json:"resource_type,omitempty"`
 		// Action performed on the resource type
-		Action string `json:"action,omitempty"`
+		Action Action `json:"action,omitempty"`
 		// Links to cusomer and payment
 		Links eventLinks `json:"links"`
 		//
@@ -23,7 +30,7 @@ type (
 			// source of event i.e. API
 			Origin string `json:"origin,omitempty"`
 			// description code
-			Cause string `json:"cause,omitempty"`
+			Cause Cause `json:"cause,omitempty"`
 			// long form description of the detail
 			Description string `json:"description,omitempty"`
 			// payment scheme
@@ -36,6 +43,7 @@ type (
 		Metadata map[string]string `json:"metadata,omitempty"`
 	}
 	eventLinks struct {
+		BillingRequestID     string `json:"billing_request,omitempty"`
 		RefundID             string `json:"refund,omitempty"`
 		MandateID            string `json:"mandate,omitempty"`
 		PaymentID            string `json:"payment,omitempty"`
@@ -49,9 +57,158 @@ type (
 	EventList struct {
 		Events []*Event `json:"events"`
 	}
+
+	// eventWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+	eventWrapper struct {
+		Event *Event `json:"events"`
+	}
+
+	// EventListResponse a List response of Event instances
+	EventListResponse struct {
+		Events []*Event `json:"events"`
+		Meta   Meta     `json:"meta,omitempty"`
+		// Linked the resources embedded with the include parameter
+		Linked EventLinked `json:"linked,omitempty"`
+	}
+
+	// EventLinked resources linked to a list of events, requested with EventListParams.Include
+	EventLinked struct {
+		BillingRequests     []*BillingRequest     `json:"billing_requests,omitempty"`
+		Creditors           []*Creditor           `json:"creditors,omitempty"`
+		Customers           []*Customer           `json:"customers,omitempty"`
+		InstalmentSchedules []*InstalmentSchedule `json:"instalment_schedules,omitempty"`
+		Mandates            []*Mandate            `json:"mandates,omitempty"`
+		Payments            []*Payment            `json:"payments,omitempty"`
+		Payouts             []*Payout             `json:"payouts,omitempty"`
+		Refunds             []*Refund             `json:"refunds,omitempty"`
+		Subscriptions       []*Subscription       `json:"subscriptions,omitempty"`
+	}
+
+	// EventListParams parameters to filter and paginate the list of events
+	EventListParams struct {
+		ListParams
+		// CreatedAt filters events by creation time
+		CreatedAt TimeRange
+		// ResourceType only returns events of this resource type, e.g. payments
+		ResourceType ResourceType
+		// Action only returns events with this action, e.g. failed
+		Action Action
+		// Include embeds the resources of this type linked to the events in the response, e.g. payment.
+		// The events must be filtered by the matching resource type. While iterating with Events,
+		// EventIterator.Linked returns the resources of the current page
+		Include string
+		// BillingRequest only returns events of this billing request ID
+		BillingRequest string
+		// Creditor only returns events of this creditor ID
+		Creditor string
+		// InstalmentSchedule only returns events of this instalment schedule ID
+		InstalmentSchedule string
+		// Mandate only returns events of this mandate ID
+		Mandate string
+		// Payment only returns events of this payment ID
+		Payment string
+		// Payout only returns events of this payout ID
+		Payout string
+		// Refund only returns events of this refund ID
+		Refund string
+		// Subscription only returns events of this subscription ID
+		Subscription string
+	}
+
+	// EventIterator iterates over a list of events, fetching further pages as needed
+	EventIterator struct {
+		pager
+		page   []*Event
+		linked EventLinked
+	}
 )
 
 func (e *Event) String() string {
 	bs, _ := json.Marshal(e)
 	return string(bs)
 }
+
+// GetEvents returns a cursor-paginated list of your events, e.g. to backfill webhooks which were missed.
+//
+// Relative endpoint: GET /events
+func (c *Client) GetEvents(ctx context.Context) (*EventListResponse, error) {
+	return c.GetEventsWithParams(ctx, nil)
+}
+
+// GetEventsWithParams is GetEvents with params filtering the list and selecting the page to return,
+// params may be nil.
+//
+// Relative endpoint: GET /events
+func (c *Client) GetEventsWithParams(ctx context.Context, params *EventListParams) (*EventListResponse, error) {
+	list := &EventListResponse{}
+
+	err := c.get(ctx, listPath(eventEndpoint, params.values()), list)
+	if err != nil {
+		return nil, err
+	}
+	return list, err
+}
+
+func (params *EventListParams) values() url.Values {
+	if params == nil {
+		return url.Values{}
+	}
+	query := params.ListParams.values()
+	params.CreatedAt.setValues(query, "created_at")
+	setString(query, "resource_type", string(params.ResourceType))
+	setString(query, "action", string(params.Action))
+	setString(query, "include", params.Include)
+	setString(query, "billing_request", params.BillingRequest)
+	setString(query, "creditor", params.Creditor)
+	setString(query, "instalment_schedule", params.InstalmentSchedule)
+	setString(query, "mandate", params.Mandate)
+	setString(query, "payment", params.Payment)
+	setString(query, "payout", params.Payout)
+	setString(query, "refund", params.Refund)
+	setString(query, "subscription", params.Subscription)
+	return query
+}
+
+// Events returns an iterator over all your events matching params, following the pagination cursors.
+// params may be nil.
+//
+// Relative endpoint: GET /events
+func (c *Client) Events(ctx context.Context, params *EventListParams) *EventIterator {
+	it := &EventIterator{}
+	it.pager = newPager(ctx, params.values(), func(ctx context.Context, query url.Values) (int, Meta, error) {
+		list := &EventListResponse{}
+		if err := c.get(ctx, listPath(eventEndpoint, query), list); err != nil {
+			return 0, Meta{}, err
+		}
+		it.page = list.Events
+		it.linked = list.Linked
+		return len(list.Events), list.Meta, nil
+	})
+	return it
+}
+
+// Event returns the current event, or nil unless the last call to Next returned true
+func (it *EventIterator) Event() *Event {
+	if !it.current {
+		return nil
+	}
+	return it.page[it.index]
+}
+
+// Linked returns the resources embedded in the current page with EventListParams.Include
+func (it *EventIterator) Linked() EventLinked {
+	return it.linked
+}
+
+// GetEvent retrieves the details of a single event.
+//
+// Relative endpoint: GET /events/EV123
+func (c *Client) GetEvent(ctx context.Context, id string) (*Event, error) {
+	wrapper := &eventWrapper{}
+
+	err := c.get(ctx, fmt.Sprintf(`%s/%s`, eventEndpoint, id), wrapper)
+	if err != nil {
+		return nil, err
+	}
+	return wrapper.Event, err
+}